@@ -3,6 +3,7 @@ package bifs
 import (
 	"fmt"
 	"math"
+	"math/bits"
 
 	"github.com/johnkerl/miller/pkg/lib"
 	"github.com/johnkerl/miller/pkg/mlrval"
@@ -75,6 +76,16 @@ func BIF_minus_unary(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 func plus_n_ii(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	a := input1.AcquireIntValue()
 	b := input2.AcquireIntValue()
+	c, overflowed := plus_ints(a, b)
+
+	if overflowed {
+		return mlrval.FromFloat(float64(a) + float64(b))
+	} else {
+		return mlrval.FromInt(c)
+	}
+}
+
+func plus_ints(a, b int64) (int64, bool) {
 	c := a + b
 
 	overflowed := false
@@ -88,11 +99,11 @@ func plus_n_ii(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 		}
 	}
 
-	if overflowed {
-		return mlrval.FromFloat(float64(a) + float64(b))
-	} else {
-		return mlrval.FromInt(c)
-	}
+	return c, overflowed
+}
+
+func plus_floats(a, b float64) float64 {
+	return a + b
 }
 
 func plus_f_if(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -128,6 +139,12 @@ func BIF_plus_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return plus_dispositions[input1.Type()][input2.Type()](input1, input2)
 }
 
+// BIF_plus_binary_in_place is as BIF_plus_binary but writes the sum into the
+// output argument, rather than allocating a new mlrval, and returns it.
+func BIF_plus_binary_in_place(output, input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return arithmetic_in_place(output, input1, input2, plus_ints, plus_floats, &plus_dispositions)
+}
+
 // ================================================================
 // Subtraction with auto-overflow from int to float when necessary.  See also
 // https://miller.readthedocs.io/en/latest/reference-main-arithmetic
@@ -137,6 +154,16 @@ func BIF_plus_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 func minus_n_ii(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	a := input1.AcquireIntValue()
 	b := input2.AcquireIntValue()
+	c, overflowed := minus_ints(a, b)
+
+	if overflowed {
+		return mlrval.FromFloat(float64(a) - float64(b))
+	} else {
+		return mlrval.FromInt(c)
+	}
+}
+
+func minus_ints(a, b int64) (int64, bool) {
 	c := a - b
 
 	overflowed := false
@@ -150,11 +177,11 @@ func minus_n_ii(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 		}
 	}
 
-	if overflowed {
-		return mlrval.FromFloat(float64(a) - float64(b))
-	} else {
-		return mlrval.FromInt(c)
-	}
+	return c, overflowed
+}
+
+func minus_floats(a, b float64) float64 {
+	return a - b
 }
 
 func minus_f_if(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -190,6 +217,12 @@ func BIF_minus_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return minus_dispositions[input1.Type()][input2.Type()](input1, input2)
 }
 
+// BIF_minus_binary_in_place is as BIF_minus_binary but writes the difference
+// into the output argument, rather than allocating a new mlrval, and returns it.
+func BIF_minus_binary_in_place(output, input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return arithmetic_in_place(output, input1, input2, minus_ints, minus_floats, &minus_dispositions)
+}
+
 // ================================================================
 // Multiplication with auto-overflow from int to float when necessary.  See
 // https://miller.readthedocs.io/en/latest/reference-main-arithmetic
@@ -226,15 +259,23 @@ func BIF_minus_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 func times_n_ii(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	a := input1.AcquireIntValue()
 	b := input2.AcquireIntValue()
-	c := float64(a) * float64(b)
+	c, overflowed := times_ints(a, b)
 
-	if math.Abs(c) > 9223372036854774784.0 {
-		return mlrval.FromFloat(c)
+	if overflowed {
+		return mlrval.FromFloat(float64(a) * float64(b))
 	} else {
-		return mlrval.FromInt(a * b)
+		return mlrval.FromInt(c)
 	}
 }
 
+func times_ints(a, b int64) (int64, bool) {
+	return a * b, math.Abs(float64(a)*float64(b)) > 9223372036854774784.0
+}
+
+func times_floats(a, b float64) float64 {
+	return a * b
+}
+
 func times_f_if(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromFloat(float64(input1.AcquireIntValue()) * input2.AcquireFloatValue())
 }
@@ -268,6 +309,51 @@ func BIF_times(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return times_dispositions[input1.Type()][input2.Type()](input1, input2)
 }
 
+// BIF_times_in_place is as BIF_times but writes the product into the output
+// argument, rather than allocating a new mlrval, and returns it.
+func BIF_times_in_place(output, input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return arithmetic_in_place(output, input1, input2, times_ints, times_floats, &times_dispositions)
+}
+
+// ----------------------------------------------------------------
+// arithmetic_in_place is the shared implementation of the in-place variants
+// of plus, minus, and times. Int and float operands, which are the hot path,
+// are computed directly into the output. All other type pairings go through
+// the operator's disposition matrix, with the result copied into the output.
+func arithmetic_in_place(
+	output, input1, input2 *mlrval.Mlrval,
+	int_op func(a, b int64) (int64, bool),
+	float_op func(a, b float64) float64,
+	dispositions *[mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc,
+) *mlrval.Mlrval {
+	type1 := input1.Type()
+	type2 := input2.Type()
+
+	if type1 == mlrval.MT_INT {
+		if type2 == mlrval.MT_INT {
+			a := input1.AcquireIntValue()
+			b := input2.AcquireIntValue()
+			c, overflowed := int_op(a, b)
+			if overflowed {
+				return output.SetFromFloat(float_op(float64(a), float64(b)))
+			} else {
+				return output.SetFromInt(c)
+			}
+		} else if type2 == mlrval.MT_FLOAT {
+			return output.SetFromFloat(float_op(float64(input1.AcquireIntValue()), input2.AcquireFloatValue()))
+		}
+	} else if type1 == mlrval.MT_FLOAT {
+		if type2 == mlrval.MT_INT {
+			return output.SetFromFloat(float_op(input1.AcquireFloatValue(), float64(input2.AcquireIntValue())))
+		} else if type2 == mlrval.MT_FLOAT {
+			return output.SetFromFloat(float_op(input1.AcquireFloatValue(), input2.AcquireFloatValue()))
+		}
+	}
+
+	*output = *dispositions[type1][type2](input1, input2)
+	return output
+}
+
 // ================================================================
 // Pythonic division.  See also
 // https://miller.readthedocs.io/en/latest/reference-main-arithmetic
@@ -652,22 +738,43 @@ func modulus_i_ii(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromInt(m)
 }
 
+// floatModulus is Pythonic float modulus: the result is zero or has the sign
+// of the divisor, and is strictly less than the divisor in absolute value.
+// Adding b to a tiny negative remainder can round to exactly b, so that case
+// is clamped to zero. A zero divisor gives NaN.
+func floatModulus(a, b float64) float64 {
+	if b == 0 {
+		return math.NaN()
+	}
+	m := math.Mod(a, b)
+	if m != 0 && (m < 0) != (b < 0) {
+		m += b
+		if m == b {
+			m = 0
+		}
+	} else if m == 0 {
+		// Avoid printing -0
+		m = 0
+	}
+	return m
+}
+
 func modulus_f_fi(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	a := input1.AcquireFloatValue()
 	b := float64(input2.AcquireIntValue())
-	return mlrval.FromFloat(a - b*math.Floor(a/b))
+	return mlrval.FromFloat(floatModulus(a, b))
 }
 
 func modulus_f_if(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	a := float64(input1.AcquireIntValue())
 	b := input2.AcquireFloatValue()
-	return mlrval.FromFloat(a - b*math.Floor(a/b))
+	return mlrval.FromFloat(floatModulus(a, b))
 }
 
 func modulus_f_ff(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	a := input1.AcquireFloatValue()
 	b := input2.AcquireFloatValue()
-	return mlrval.FromFloat(a - b*math.Floor(a/b))
+	return mlrval.FromFloat(floatModulus(a, b))
 }
 
 func modte(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -705,35 +812,50 @@ func mlrmod(a, m int64) int64 {
 
 type i_iii_func func(a, b, m int64) int64
 
+// The modular-arithmetic functions reduce their operands into [0, m) and then
+// work in uint64 so that intermediates never overflow: sums of two reduced
+// operands fit in 64 bits, and products are formed at 128 bits. The modulus
+// is assumed positive; imodop checks this.
+
 func imodadd(a, b, m int64) int64 {
-	return mlrmod(a+b, m)
+	ua := uint64(mlrmod(a, m))
+	ub := uint64(mlrmod(b, m))
+	um := uint64(m)
+	sum := ua + ub
+	if sum >= um {
+		sum -= um
+	}
+	return int64(sum)
 }
 func imodsub(a, b, m int64) int64 {
-	return mlrmod(a-b, m)
+	ua := uint64(mlrmod(a, m))
+	ub := uint64(mlrmod(b, m))
+	if ua >= ub {
+		return int64(ua - ub)
+	}
+	return int64(ua + (uint64(m) - ub))
 }
 func imodmul(a, b, m int64) int64 {
-	return mlrmod(a*b, m)
+	ua := uint64(mlrmod(a, m))
+	ub := uint64(mlrmod(b, m))
+	// Both operands are less than m so the high word is too, as Div64 requires.
+	hi, lo := bits.Mul64(ua, ub)
+	_, rem := bits.Div64(hi, lo, uint64(m))
+	return int64(rem)
 }
 func imodexp(a, e, m int64) int64 {
-	if e == 0 {
-		return 1
-	}
-	if e == 1 {
-		return a
-	}
-
 	// Repeated-squaring algorithm.
 	// We assume our caller has verified the exponent is not negative.
-	apower := a
-	c := int64(1)
+	apower := mlrmod(a, m)
+	c := mlrmod(1, m)
 	u := uint64(e)
 
 	for u != 0 {
 		if (u & 1) == 1 {
-			c = mlrmod(c*apower, m)
+			c = imodmul(c, apower, m)
 		}
 		u >>= 1
-		apower = mlrmod(apower*apower, m)
+		apower = imodmul(apower, apower, m)
 	}
 	return c
 }
@@ -751,12 +873,18 @@ func imodop(input1, input2, input3 *mlrval.Mlrval, iop i_iii_func, funcname stri
 	if !input1.IsInt() || !input2.IsInt() || !input3.IsInt() {
 		return mlrval.FromTypeErrorTernary(funcname, input1, input2, input3)
 	}
+	m := input3.AcquireIntValue()
+	if m <= 0 {
+		return mlrval.FromError(
+			fmt.Errorf("%s: modulus must be positive; got %d", funcname, m),
+		)
+	}
 
 	return mlrval.FromInt(
 		iop(
 			input1.AcquireIntValue(),
 			input2.AcquireIntValue(),
-			input3.AcquireIntValue(),
+			m,
 		),
 	)
 }
@@ -798,13 +926,26 @@ func BIF_mod_exp(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
 // * false < true
 // Exceptions for min & max:
 // * absent-null always loses
-// * empty-null always loses against numbers
+// * empty-null sorts after numerics and booleans, and before other strings
 
 // ----------------------------------------------------------------
+// floatInputOrResult returns whichever float input has exactly the value c, so
+// that e.g. min/max keep the original formatting of values read from data,
+// such as "1.10" rather than "1.1". Otherwise, e.g. for NaN, it returns c.
+func floatInputOrResult(c float64, input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	if math.Float64bits(c) == math.Float64bits(input1.AcquireFloatValue()) {
+		return input1
+	} else if math.Float64bits(c) == math.Float64bits(input2.AcquireFloatValue()) {
+		return input2
+	} else {
+		return mlrval.FromFloat(c)
+	}
+}
+
 func min_f_ff(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	var a float64 = input1.AcquireFloatValue()
 	var b float64 = input2.AcquireFloatValue()
-	return mlrval.FromFloat(math.Min(a, b))
+	return floatInputOrResult(math.Min(a, b), input1, input2)
 }
 
 func min_f_fi(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -974,7 +1115,7 @@ func BIF_minlen_within_map_values(m *mlrval.Mlrmap) *mlrval.Mlrval {
 func max_f_ff(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	var a float64 = input1.AcquireFloatValue()
 	var b float64 = input2.AcquireFloatValue()
-	return mlrval.FromFloat(math.Max(a, b))
+	return floatInputOrResult(math.Max(a, b), input1, input2)
 }
 
 func max_f_fi(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -1,6 +1,8 @@
 package bifs
 
 import (
+	"math"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -79,12 +81,199 @@ func TestBIF_plus_binary_overflow(t *testing.T) {
 //func BIF_dot_times(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval
 //func BIF_dot_divide(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval
 //func BIF_dot_int_divide(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval
-//func BIF_modulus(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval
-//func BIF_mod_add(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval
-//func BIF_mod_sub(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval
-//func BIF_mod_mul(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval
-//func BIF_mod_exp(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval
-//func BIF_min_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval
-//func BIF_min_variadic(mlrvals []*mlrval.Mlrval) *mlrval.Mlrval
-//func BIF_max_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval
-//func BIF_max_variadic(mlrvals []*mlrval.Mlrval) *mlrval.Mlrval
+
+func TestBIF_modulus_float(t *testing.T) {
+	cases := []struct {
+		a, b     float64
+		expected float64
+	}{
+		{5.5, 2.0, 1.5},
+		{-5.5, 2.0, 0.5},
+		{5.5, -2.0, -0.5},
+		{-5.5, -2.0, -1.5},
+		{-0.1, 0.3, 0.19999999999999998},
+		{0.1, -0.3, -0.19999999999999998},
+		{6.0, -3.0, 0.0},
+		// The remainder is tiny and negative; adding the divisor rounds to it.
+		{-1e-20, 0.3, 0.0},
+		{1e-20, -0.3, 0.0},
+	}
+	for _, c := range cases {
+		output := BIF_modulus(mlrval.FromFloat(c.a), mlrval.FromFloat(c.b))
+		actual, ok := output.GetFloatValue()
+		assert.True(t, ok, "%v %% %v", c.a, c.b)
+		assert.Equal(t, c.expected, actual, "%v %% %v", c.a, c.b)
+		assert.False(t, math.Signbit(actual) && actual == 0, "%v %% %v", c.a, c.b)
+		assert.True(t, math.Abs(actual) < math.Abs(c.b), "%v %% %v", c.a, c.b)
+	}
+
+	// Mixed int and float
+	actual, _ := BIF_modulus(mlrval.FromInt(-7), mlrval.FromFloat(2.5)).GetFloatValue()
+	assert.Equal(t, 0.5, actual)
+	actual, _ = BIF_modulus(mlrval.FromFloat(7.5), mlrval.FromInt(-2)).GetFloatValue()
+	assert.Equal(t, -0.5, actual)
+
+	// Zero divisor
+	for _, a := range []*mlrval.Mlrval{mlrval.FromFloat(5.5), mlrval.FromInt(5), mlrval.FromFloat(0.0)} {
+		output := BIF_modulus(a, mlrval.FromFloat(0.0))
+		actual, ok := output.GetFloatValue()
+		assert.True(t, ok)
+		assert.True(t, math.IsNaN(actual), "%s %% 0.0", a.String())
+	}
+}
+
+func TestBIF_mod_ops(t *testing.T) {
+	type bigop func(z, x, y *big.Int) *big.Int
+	cases := []struct {
+		name  string
+		bif   TernaryFunc
+		bigop bigop
+	}{
+		{"madd", BIF_mod_add, (*big.Int).Add},
+		{"msub", BIF_mod_sub, (*big.Int).Sub},
+		{"mmul", BIF_mod_mul, (*big.Int).Mul},
+		{"mexp", BIF_mod_exp, func(z, x, y *big.Int) *big.Int { return z.Exp(x, y, nil) }},
+	}
+	operands := []int64{0, 1, 2, 5, -7, 1 << 40, math.MaxInt64, math.MaxInt64 - 1, math.MinInt64, -(1 << 62)}
+	moduli := []int64{1, 7, 1000000007, 1 << 62, math.MaxInt64}
+
+	for _, c := range cases {
+		for _, a := range operands {
+			for _, b := range operands {
+				if c.name == "mexp" && (b < 0 || b > 1000) {
+					continue // keep the big.Int reference cheap
+				}
+				for _, m := range moduli {
+					expected := c.bigop(new(big.Int), big.NewInt(a), big.NewInt(b))
+					expected.Mod(expected, big.NewInt(m))
+
+					output := c.bif(mlrval.FromInt(a), mlrval.FromInt(b), mlrval.FromInt(m))
+					actual, ok := output.GetIntValue()
+					assert.True(t, ok, "%s(%d,%d,%d)", c.name, a, b, m)
+					assert.Equal(t, expected.Int64(), actual, "%s(%d,%d,%d)", c.name, a, b, m)
+				}
+			}
+		}
+	}
+
+	// Large exponents, checked against big.Int with its own modulus
+	for _, m := range moduli {
+		a := int64(math.MaxInt64 - 24)
+		e := int64(1<<62 + 12345)
+		expected := new(big.Int).Exp(big.NewInt(a), big.NewInt(e), big.NewInt(m))
+		actual, _ := BIF_mod_exp(mlrval.FromInt(a), mlrval.FromInt(e), mlrval.FromInt(m)).GetIntValue()
+		assert.Equal(t, expected.Int64(), actual, "mexp(%d,%d,%d)", a, e, m)
+	}
+}
+
+func TestBIF_mod_ops_errors(t *testing.T) {
+	one := mlrval.FromInt(1)
+	for _, bif := range []TernaryFunc{BIF_mod_add, BIF_mod_sub, BIF_mod_mul, BIF_mod_exp} {
+		assert.True(t, bif(mlrval.FromFloat(1.5), one, mlrval.FromInt(7)).IsError())
+		assert.True(t, bif(one, mlrval.FromString("abc"), mlrval.FromInt(7)).IsError())
+		assert.True(t, bif(one, one, mlrval.FromFloat(7.0)).IsError())
+		assert.True(t, bif(one, one, mlrval.FromInt(0)).IsError())
+		assert.True(t, bif(one, one, mlrval.FromInt(-7)).IsError())
+	}
+	assert.True(t, BIF_mod_exp(one, mlrval.FromInt(-1), mlrval.FromInt(7)).IsError())
+}
+
+func TestBIF_min_max_variadic(t *testing.T) {
+	one := mlrval.FromInt(1)
+	two := mlrval.FromFloat(2.5)
+	minus := mlrval.FromInt(-3)
+	abc := mlrval.FromString("abc")
+	def := mlrval.FromString("def")
+	tru := mlrval.FromBool(true)
+
+	cases := []struct {
+		inputs []*mlrval.Mlrval
+		min    string
+		max    string
+	}{
+		{[]*mlrval.Mlrval{one, two, minus}, "-3", "2.5"},
+		{[]*mlrval.Mlrval{minus}, "-3", "-3"},
+		{[]*mlrval.Mlrval{def, abc}, "abc", "def"},
+		// Numbers before booleans before empty before strings
+		{[]*mlrval.Mlrval{abc, one}, "1", "abc"},
+		{[]*mlrval.Mlrval{one, abc, two}, "1", "abc"},
+		{[]*mlrval.Mlrval{tru, two}, "2.5", "true"},
+		{[]*mlrval.Mlrval{tru, abc}, "true", "abc"},
+		{[]*mlrval.Mlrval{mlrval.VOID, one}, "1", ""},
+		{[]*mlrval.Mlrval{abc, mlrval.VOID}, "", "abc"},
+		// Absent loses
+		{[]*mlrval.Mlrval{mlrval.ABSENT, one, mlrval.ABSENT, minus}, "-3", "1"},
+		{[]*mlrval.Mlrval{abc, mlrval.ABSENT}, "abc", "abc"},
+		// Recursion into collections
+		{[]*mlrval.Mlrval{mlrval.FromArray([]*mlrval.Mlrval{one, minus}), two}, "-3", "2.5"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.min, BIF_min_variadic(c.inputs).String(), "min(%v)", c.inputs)
+		assert.Equal(t, c.max, BIF_max_variadic(c.inputs).String(), "max(%v)", c.inputs)
+	}
+
+	allAbsent := []*mlrval.Mlrval{mlrval.ABSENT, mlrval.ABSENT}
+	assert.True(t, BIF_min_variadic(allAbsent).IsAbsent())
+	assert.True(t, BIF_max_variadic(allAbsent).IsAbsent())
+
+	assert.True(t, BIF_min_variadic([]*mlrval.Mlrval{}).IsVoid())
+	assert.True(t, BIF_max_variadic([]*mlrval.Mlrval{}).IsVoid())
+
+	// Int-ness is kept when all inputs are ints
+	assert.True(t, BIF_max_variadic([]*mlrval.Mlrval{one, minus}).IsInt())
+}
+
+func TestBIF_min_max_keep_float_formatting(t *testing.T) {
+	a := mlrval.FromInferredType("1.10")
+	b := mlrval.FromInferredType("1e3")
+	assert.Equal(t, "1.10", BIF_min_binary(a, b).String())
+	assert.Equal(t, "1e3", BIF_max_binary(a, b).String())
+	assert.Equal(t, "1.10", BIF_max_variadic([]*mlrval.Mlrval{a, mlrval.FromFloat(0.5)}).String())
+
+	// Mixed int and float is computed as float
+	assert.Equal(t, "2", BIF_max_binary(mlrval.FromInt(2), a).String())
+
+	nan := BIF_max_binary(a, mlrval.FromFloat(math.NaN()))
+	floatval, ok := nan.GetFloatValue()
+	assert.True(t, ok)
+	assert.True(t, math.IsNaN(floatval))
+}
+
+func TestInPlaceArithmetic(t *testing.T) {
+	output := mlrval.FromString("abc")
+	input1 := mlrval.FromInt(3)
+	input2 := mlrval.FromFloat(0.5)
+
+	assert.Equal(t, "3.5", BIF_plus_binary_in_place(output, input1, input2).String())
+	assert.Equal(t, "2.5", BIF_minus_binary_in_place(output, input1, input2).String())
+	assert.Equal(t, "9", BIF_times_in_place(output, input1, input1).String())
+	assert.True(t, output.IsInt())
+
+	// Int overflow to float
+	big := mlrval.FromInt(0x7fffffffffffffff)
+	assert.True(t, BIF_plus_binary_in_place(output, big, big).IsFloat())
+	assert.True(t, BIF_times_in_place(output, big, big).IsFloat())
+
+	// Non-numeric pairings go through the disposition matrices
+	assert.Equal(t, "3", BIF_plus_binary_in_place(output, input1, mlrval.ABSENT).String())
+	assert.True(t, BIF_times_in_place(output, input1, mlrval.FromString("x")).IsError())
+}
+
+// go test -run=nonesuch -bench=Plus -benchmem github.com/johnkerl/miller/pkg/bifs/...
+
+func BenchmarkPlusBinary(b *testing.B) {
+	input1 := mlrval.FromFloat(1.5)
+	input2 := mlrval.FromFloat(2.5)
+	for i := 0; i < b.N; i++ {
+		_ = BIF_plus_binary(input1, input2)
+	}
+}
+
+func BenchmarkPlusBinaryInPlace(b *testing.B) {
+	input1 := mlrval.FromFloat(1.5)
+	input2 := mlrval.FromFloat(2.5)
+	output := &mlrval.Mlrval{}
+	for i := 0; i < b.N; i++ {
+		_ = BIF_plus_binary_in_place(output, input1, input2)
+	}
+}
@@ -77,6 +77,10 @@ type mathLibUnaryFuncWrapper func(input1 *mlrval.Mlrval, f mathLibUnaryFunc, fna
 // Function-pointer type for binary-operator disposition matrices.
 type BinaryFunc func(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval
 
+// Function-pointer type for in-place variants of binary operators, which
+// write their result into the output argument and return it.
+type BinaryFuncInPlace func(output, input1, input2 *mlrval.Mlrval) *mlrval.Mlrval
+
 // Function-pointer type for ternary functions
 type TernaryFunc func(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval
 
@@ -1,6 +1,7 @@
 package bifs
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -14,6 +15,26 @@ func TestBIF_bitcount(t *testing.T) {
 	intval, ok := output.GetIntValue()
 	assert.True(t, ok)
 	assert.Equal(t, int64(11), intval)
+
+	cases := []struct {
+		input    int64
+		expected int64
+	}{
+		{0, 0},
+		{1, 1},
+		{255, 8},
+		{-1, 64},
+		{math.MaxInt64, 63},
+		{math.MinInt64, 1},
+	}
+	for _, c := range cases {
+		intval, ok := BIF_bitcount(mlrval.FromInt(c.input)).GetIntValue()
+		assert.True(t, ok)
+		assert.Equal(t, c.expected, intval, "bitcount(%d)", c.input)
+	}
+
+	assert.True(t, BIF_bitcount(mlrval.FromFloat(1.0)).IsError())
+	assert.True(t, BIF_bitcount(mlrval.FromString("abc")).IsError())
 }
 
 // TODO: copy in more unit-test cases from existing regression-test data
@@ -32,6 +32,84 @@ func BIF_logical_OR(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	}
 }
 
+// BIF_logical_AND_lazy and BIF_logical_OR_lazy are for the DSL evaluator,
+// which needs "&&" and "||" to short-circuit: the right-hand side is evaluated,
+// via evalRight, only when the left-hand side doesn't determine the result.
+// This means false && (anything) is false, and true || (anything) is true,
+// even when the right-hand side would have been an error.
+//
+// Otherwise:
+//   - An error on the left is returned as-is.
+//   - Absent follows the usual absent rules: absent && b is b, and a && absent
+//     is a. Absent && absent is absent.
+//   - An empty left-hand side is like absent, except that empty && empty is
+//     an error.
+//   - Any other non-boolean operand is an error.
+func BIF_logical_AND_lazy(input1 *mlrval.Mlrval, evalRight func() *mlrval.Mlrval) *mlrval.Mlrval {
+	return logicalLazy("&&", input1, evalRight, false, BIF_logical_AND)
+}
+
+// BIF_logical_OR_lazy is as BIF_logical_AND_lazy but for "||".
+func BIF_logical_OR_lazy(input1 *mlrval.Mlrval, evalRight func() *mlrval.Mlrval) *mlrval.Mlrval {
+	return logicalLazy("||", input1, evalRight, true, BIF_logical_OR)
+}
+
+// logicalLazy is the shared logic for BIF_logical_AND_lazy and
+// BIF_logical_OR_lazy. A left-hand side equal to shortCircuitValue is the
+// result, without evaluating the right-hand side.
+func logicalLazy(
+	opname string,
+	input1 *mlrval.Mlrval,
+	evalRight func() *mlrval.Mlrval,
+	shortCircuitValue bool,
+	eager BinaryFunc,
+) *mlrval.Mlrval {
+	atype := input1.Type()
+
+	if atype == mlrval.MT_ERROR {
+		return input1
+	}
+
+	if atype == mlrval.MT_ABSENT || atype == mlrval.MT_VOID {
+		input2 := evalRight()
+		btype := input2.Type()
+		if btype == mlrval.MT_ERROR {
+			return input2
+		}
+		if btype == mlrval.MT_ABSENT {
+			return mlrval.ABSENT
+		}
+		if btype == mlrval.MT_VOID {
+			if atype == mlrval.MT_ABSENT {
+				return mlrval.ABSENT
+			}
+			return mlrval.FromNotNamedTypeError(opname, input2, "absent or boolean")
+		}
+		if btype != mlrval.MT_BOOL {
+			return mlrval.FromNotNamedTypeError(opname, input2, "absent or boolean")
+		}
+		return input2
+	}
+
+	if boolValue, isBool := input1.GetBoolValue(); isBool && boolValue == shortCircuitValue {
+		return input1
+	}
+
+	input2 := evalRight()
+	btype := input2.Type()
+	if btype == mlrval.MT_ABSENT {
+		if atype == mlrval.MT_BOOL {
+			return input1
+		}
+		return mlrval.FromNotNamedTypeError(opname, input1, "absent or boolean")
+	}
+	if btype != mlrval.MT_BOOL {
+		return mlrval.FromNotNamedTypeError(opname, input2, "absent or boolean")
+	}
+
+	return eager(input1, input2)
+}
+
 func BIF_logical_XOR(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	if input1.IsBool() && input2.IsBool() {
 		return mlrval.FromBool(input1.AcquireBoolValue() != input2.AcquireBoolValue())
@@ -39,3 +117,26 @@ func BIF_logical_XOR(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 		return mlrval.FromTypeErrorUnary("^^", input1)
 	}
 }
+
+// BIF_ternary is the eager form of the "?:" operator: it returns input2 when
+// input1 is true and input3 when it is false. An absent condition gives
+// absent; any other non-boolean condition is an error.
+//
+// All three operands are already evaluated here, so this does not
+// short-circuit. The DSL evaluator must evaluate the condition first and then
+// only the selected branch -- see StandardTernaryOperatorNode in the cst
+// package.
+func BIF_ternary(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
+	if input1.IsAbsent() {
+		return input1
+	}
+	boolValue, isBool := input1.GetBoolValue()
+	if !isBool {
+		return mlrval.FromNotBooleanError("?:", input1)
+	}
+	if boolValue {
+		return input2
+	} else {
+		return input3
+	}
+}
@@ -0,0 +1,114 @@
+package bifs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnkerl/miller/pkg/mlrval"
+)
+
+func TestBIF_ternary(t *testing.T) {
+	a := mlrval.FromString("a")
+	b := mlrval.FromInt(2)
+
+	assert.Equal(t, a, BIF_ternary(mlrval.TRUE, a, b))
+	assert.Equal(t, b, BIF_ternary(mlrval.FALSE, a, b))
+
+	// Branches are returned as-is, whatever their type
+	assert.True(t, BIF_ternary(mlrval.TRUE, mlrval.ABSENT, b).IsAbsent())
+	assert.True(t, BIF_ternary(mlrval.FALSE, a, mlrval.VOID).IsVoid())
+
+	assert.True(t, BIF_ternary(mlrval.ABSENT, a, b).IsAbsent())
+
+	assert.True(t, BIF_ternary(mlrval.VOID, a, b).IsError())
+	assert.True(t, BIF_ternary(mlrval.FromInt(1), a, b).IsError())
+	assert.True(t, BIF_ternary(mlrval.FromString("true"), a, b).IsError())
+	assert.True(t, BIF_ternary(mlrval.FromEmptyMap(), a, b).IsError())
+	assert.True(t, BIF_ternary(mlrval.FromAnonymousError(), a, b).IsError())
+}
+
+// rightHandSide returns a thunk for BIF_logical_AND_lazy et al. which counts
+// how many times it was called.
+func rightHandSide(value *mlrval.Mlrval, ncalls *int) func() *mlrval.Mlrval {
+	return func() *mlrval.Mlrval {
+		*ncalls++
+		return value
+	}
+}
+
+func TestBIF_logical_AND_lazy(t *testing.T) {
+	ncalls := 0
+
+	// Short-circuited: the right-hand side isn't evaluated, even if it is an error
+	output := BIF_logical_AND_lazy(mlrval.FALSE, rightHandSide(mlrval.FromAnonymousError(), &ncalls))
+	assert.Equal(t, mlrval.FALSE, output)
+	assert.Equal(t, 0, ncalls)
+
+	cases := []struct {
+		left     *mlrval.Mlrval
+		right    *mlrval.Mlrval
+		expected string
+	}{
+		{mlrval.TRUE, mlrval.TRUE, "true"},
+		{mlrval.TRUE, mlrval.FALSE, "false"},
+		{mlrval.TRUE, mlrval.ABSENT, "true"},
+		{mlrval.ABSENT, mlrval.TRUE, "true"},
+		{mlrval.ABSENT, mlrval.FALSE, "false"},
+		{mlrval.ABSENT, mlrval.ABSENT, "(absent)"},
+		{mlrval.VOID, mlrval.TRUE, "true"},
+		{mlrval.VOID, mlrval.ABSENT, "(absent)"},
+		{mlrval.TRUE, mlrval.FromInt(3), "(error)"},
+		{mlrval.TRUE, mlrval.VOID, "(error)"},
+		{mlrval.VOID, mlrval.VOID, "(error)"},
+		{mlrval.FromInt(3), mlrval.TRUE, "(error)"},
+		{mlrval.FromInt(3), mlrval.ABSENT, "(error)"},
+	}
+	for _, c := range cases {
+		ncalls = 0
+		output := BIF_logical_AND_lazy(c.left, rightHandSide(c.right, &ncalls))
+		assert.Equal(t, c.expected, output.String(), "%s && %s", c.left.String(), c.right.String())
+		assert.Equal(t, 1, ncalls, "%s && %s", c.left.String(), c.right.String())
+	}
+
+	// An error on the left is returned without evaluating the right
+	ncalls = 0
+	assert.True(t, BIF_logical_AND_lazy(mlrval.FromAnonymousError(), rightHandSide(mlrval.TRUE, &ncalls)).IsError())
+	assert.Equal(t, 0, ncalls)
+}
+
+func TestBIF_logical_OR_lazy(t *testing.T) {
+	ncalls := 0
+
+	// Short-circuited: the right-hand side isn't evaluated, even if it is an error
+	output := BIF_logical_OR_lazy(mlrval.TRUE, rightHandSide(mlrval.FromAnonymousError(), &ncalls))
+	assert.Equal(t, mlrval.TRUE, output)
+	assert.Equal(t, 0, ncalls)
+
+	cases := []struct {
+		left     *mlrval.Mlrval
+		right    *mlrval.Mlrval
+		expected string
+	}{
+		{mlrval.FALSE, mlrval.TRUE, "true"},
+		{mlrval.FALSE, mlrval.FALSE, "false"},
+		{mlrval.FALSE, mlrval.ABSENT, "false"},
+		{mlrval.ABSENT, mlrval.TRUE, "true"},
+		{mlrval.ABSENT, mlrval.FALSE, "false"},
+		{mlrval.ABSENT, mlrval.ABSENT, "(absent)"},
+		{mlrval.VOID, mlrval.FALSE, "false"},
+		{mlrval.FALSE, mlrval.FromInt(3), "(error)"},
+		{mlrval.FALSE, mlrval.VOID, "(error)"},
+		{mlrval.FromInt(3), mlrval.FALSE, "(error)"},
+	}
+	for _, c := range cases {
+		ncalls = 0
+		output := BIF_logical_OR_lazy(c.left, rightHandSide(c.right, &ncalls))
+		assert.Equal(t, c.expected, output.String(), "%s || %s", c.left.String(), c.right.String())
+		assert.Equal(t, 1, ncalls, "%s || %s", c.left.String(), c.right.String())
+	}
+
+	ncalls = 0
+	assert.True(t, BIF_logical_OR_lazy(mlrval.FromAnonymousError(), rightHandSide(mlrval.TRUE, &ncalls)).IsError())
+	assert.Equal(t, 0, ncalls)
+}
@@ -9,6 +9,18 @@ import (
 	"github.com/johnkerl/miller/pkg/mlrval"
 )
 
+// Ordering comparisons between a number and a string (or empty value) put
+// numbers before strings, the same as the sort verb and the sort and min/max
+// functions do. With mlr --lexical-mixed, the number is instead compared as a
+// string, as in earlier Miller versions. Equality comparisons are not
+// affected: the number is compared as a string either way.
+var lexicalMixedComparisons = false
+
+// SetLexicalMixedComparisons is for mlr --lexical-mixed.
+func SetLexicalMixedComparisons(onOff bool) {
+	lexicalMixedComparisons = onOff
+}
+
 //   - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
 //
 // string_cmp implements the spaceship operator for strings.
@@ -75,19 +87,34 @@ func ne_b_xs(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromBool(input1.String() != input2.AcquireStringValue())
 }
 func gt_b_xs(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
-	return mlrval.FromBool(input1.String() > input2.AcquireStringValue())
+	if lexicalMixedComparisons {
+		return mlrval.FromBool(input1.String() > input2.AcquireStringValue())
+	}
+	return mlrval.FALSE
 }
 func ge_b_xs(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
-	return mlrval.FromBool(input1.String() >= input2.AcquireStringValue())
+	if lexicalMixedComparisons {
+		return mlrval.FromBool(input1.String() >= input2.AcquireStringValue())
+	}
+	return mlrval.FALSE
 }
 func lt_b_xs(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
-	return mlrval.FromBool(input1.String() < input2.AcquireStringValue())
+	if lexicalMixedComparisons {
+		return mlrval.FromBool(input1.String() < input2.AcquireStringValue())
+	}
+	return mlrval.TRUE
 }
 func le_b_xs(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
-	return mlrval.FromBool(input1.String() <= input2.AcquireStringValue())
+	if lexicalMixedComparisons {
+		return mlrval.FromBool(input1.String() <= input2.AcquireStringValue())
+	}
+	return mlrval.TRUE
 }
 func cmp_b_xs(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
-	return mlrval.FromInt(int64(string_cmp(input1.String(), input2.AcquireStringValue())))
+	if lexicalMixedComparisons {
+		return mlrval.FromInt(int64(string_cmp(input1.String(), input2.AcquireStringValue())))
+	}
+	return mlrval.FromInt(-1)
 }
 
 // - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
@@ -98,19 +125,34 @@ func ne_b_sx(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromBool(input1.AcquireStringValue() != input2.String())
 }
 func gt_b_sx(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
-	return mlrval.FromBool(input1.AcquireStringValue() > input2.String())
+	if lexicalMixedComparisons {
+		return mlrval.FromBool(input1.AcquireStringValue() > input2.String())
+	}
+	return mlrval.TRUE
 }
 func ge_b_sx(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
-	return mlrval.FromBool(input1.AcquireStringValue() >= input2.String())
+	if lexicalMixedComparisons {
+		return mlrval.FromBool(input1.AcquireStringValue() >= input2.String())
+	}
+	return mlrval.TRUE
 }
 func lt_b_sx(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
-	return mlrval.FromBool(input1.AcquireStringValue() < input2.String())
+	if lexicalMixedComparisons {
+		return mlrval.FromBool(input1.AcquireStringValue() < input2.String())
+	}
+	return mlrval.FALSE
 }
 func le_b_sx(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
-	return mlrval.FromBool(input1.AcquireStringValue() <= input2.String())
+	if lexicalMixedComparisons {
+		return mlrval.FromBool(input1.AcquireStringValue() <= input2.String())
+	}
+	return mlrval.FALSE
 }
 func cmp_b_sx(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
-	return mlrval.FromInt(string_cmp(input1.AcquireStringValue(), input2.String()))
+	if lexicalMixedComparisons {
+		return mlrval.FromInt(string_cmp(input1.AcquireStringValue(), input2.String()))
+	}
+	return mlrval.FromInt(1)
 }
 
 // - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
@@ -0,0 +1,55 @@
+package bifs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnkerl/miller/pkg/mlrval"
+)
+
+func TestMixedNumberStringOrdering(t *testing.T) {
+	ten := mlrval.FromInt(10)
+	half := mlrval.FromFloat(0.5)
+	nine := mlrval.FromString("9")
+	plus := mlrval.FromString("+")
+
+	// Numbers are before strings, including the empty string
+	for _, number := range []*mlrval.Mlrval{ten, half} {
+		for _, str := range []*mlrval.Mlrval{nine, plus, mlrval.VOID} {
+			assert.True(t, BIF_less_than(number, str).AcquireBoolValue())
+			assert.True(t, BIF_less_than_or_equals(number, str).AcquireBoolValue())
+			assert.False(t, BIF_greater_than(number, str).AcquireBoolValue())
+			assert.False(t, BIF_greater_than_or_equals(number, str).AcquireBoolValue())
+			assert.Equal(t, int64(-1), BIF_cmp(number, str).AcquireIntValue())
+
+			assert.False(t, BIF_less_than(str, number).AcquireBoolValue())
+			assert.True(t, BIF_greater_than(str, number).AcquireBoolValue())
+			assert.Equal(t, int64(1), BIF_cmp(str, number).AcquireIntValue())
+		}
+	}
+
+	// Same as the collating order used for sorting
+	assert.Equal(t, mlrval.Cmp(ten, plus), int(BIF_cmp(ten, plus).AcquireIntValue()))
+	assert.Equal(t, mlrval.Cmp(plus, ten), int(BIF_cmp(plus, ten).AcquireIntValue()))
+}
+
+func TestMixedNumberStringOrderingLexical(t *testing.T) {
+	SetLexicalMixedComparisons(true)
+	defer SetLexicalMixedComparisons(false)
+
+	ten := mlrval.FromInt(10)
+	nine := mlrval.FromString("9")
+	plus := mlrval.FromString("+")
+
+	// "10" < "9" as strings either way
+	assert.True(t, BIF_less_than(ten, nine).AcquireBoolValue())
+	assert.False(t, BIF_less_than(nine, ten).AcquireBoolValue())
+
+	// "+" and "" are lexically before "10"
+	assert.False(t, BIF_less_than(ten, plus).AcquireBoolValue())
+	assert.True(t, BIF_greater_than(ten, plus).AcquireBoolValue())
+	assert.True(t, BIF_greater_than_or_equals(ten, mlrval.VOID).AcquireBoolValue())
+	assert.Equal(t, int64(1), BIF_cmp(ten, plus).AcquireIntValue())
+	assert.Equal(t, int64(-1), BIF_cmp(plus, ten).AcquireIntValue())
+}
@@ -211,39 +211,32 @@ func BIF_concat(mlrvals []*mlrval.Mlrval) *mlrval.Mlrval {
 
 // ================================================================
 func BIF_mapselect(mlrvals []*mlrval.Mlrval) *mlrval.Mlrval {
+	return mapselectNamed("mapselect", mlrvals)
+}
+
+// BIF_maponly is a synonym for BIF_mapselect, with its own name in error messages.
+func BIF_maponly(mlrvals []*mlrval.Mlrval) *mlrval.Mlrval {
+	return mapselectNamed("maponly", mlrvals)
+}
+
+func mapselectNamed(funcname string, mlrvals []*mlrval.Mlrval) *mlrval.Mlrval {
 	if len(mlrvals) < 1 {
-		return mlrval.FromErrorString("mapselect: received a zero-length array as input")
+		return mlrval.FromErrorString(funcname + ": received a zero-length array as input")
 	}
 	if !mlrvals[0].IsMap() {
-		return mlrval.FromNotMapError("mapselect", mlrvals[0])
+		return mlrval.FromNotMapError(funcname, mlrvals[0])
 	}
 	oldmap := mlrvals[0].AcquireMapValue()
 	newMap := mlrval.NewMlrmap()
 
-	newKeys := make(map[string]bool)
-	for _, selectArg := range mlrvals[1:] {
-		if selectArg.IsString() {
-			newKeys[selectArg.AcquireStringValue()] = true
-		} else if selectArg.IsInt() {
-			newKeys[selectArg.String()] = true
-		} else if selectArg.IsArray() {
-			for _, element := range selectArg.AcquireArrayValue() {
-				if element.IsString() {
-					newKeys[element.AcquireStringValue()] = true
-				} else {
-					return mlrval.FromNotStringError("mapselect", element)
-				}
-			}
-		} else {
-			return mlrval.FromNotNamedTypeError("mapselect", selectArg, "string, int, or array")
-		}
+	newKeys, errval := getMapKeyArgs(funcname, mlrvals[1:])
+	if errval != nil {
+		return errval
 	}
 
 	for pe := oldmap.Head; pe != nil; pe = pe.Next {
-		oldKey := pe.Key
-		_, present := newKeys[oldKey]
-		if present {
-			newMap.PutCopy(oldKey, oldmap.Get(oldKey))
+		if newKeys[pe.Key] {
+			newMap.PutCopy(pe.Key, pe.Value)
 		}
 	}
 
@@ -258,27 +251,40 @@ func BIF_mapexcept(mlrvals []*mlrval.Mlrval) *mlrval.Mlrval {
 	if !mlrvals[0].IsMap() {
 		return mlrval.FromNotMapError("mapexcept", mlrvals[0])
 	}
+
+	exceptKeys, errval := getMapKeyArgs("mapexcept", mlrvals[1:])
+	if errval != nil {
+		return errval
+	}
+
 	newMap := mlrvals[0].AcquireMapValue().Copy()
+	for key := range exceptKeys {
+		newMap.Remove(key)
+	}
+
+	return mlrval.FromMap(newMap)
+}
 
-	for _, exceptArg := range mlrvals[1:] {
-		if exceptArg.IsString() {
-			newMap.Remove(exceptArg.AcquireStringValue())
-		} else if exceptArg.IsInt() {
-			newMap.Remove(exceptArg.String())
-		} else if exceptArg.IsArray() {
-			for _, element := range exceptArg.AcquireArrayValue() {
-				if element.IsString() {
-					newMap.Remove(element.AcquireStringValue())
+// getMapKeyArgs is for mapselect and mapexcept, whose key arguments may be
+// strings, ints, or arrays of strings and ints.
+func getMapKeyArgs(funcname string, keyArgs []*mlrval.Mlrval) (map[string]bool, *mlrval.Mlrval) {
+	keys := make(map[string]bool)
+	for _, keyArg := range keyArgs {
+		if keyArg.IsString() || keyArg.IsInt() {
+			keys[keyArg.String()] = true
+		} else if keyArg.IsArray() {
+			for _, element := range keyArg.AcquireArrayValue() {
+				if element.IsString() || element.IsInt() {
+					keys[element.String()] = true
 				} else {
-					return mlrval.FromNotStringError("mapselect", element)
+					return nil, mlrval.FromNotNamedTypeError(funcname, element, "string or int")
 				}
 			}
 		} else {
-			return mlrval.FromNotNamedTypeError("mapexcept", exceptArg, "string, int, or array")
+			return nil, mlrval.FromNotNamedTypeError(funcname, keyArg, "string, int, or array")
 		}
 	}
-
-	return mlrval.FromMap(newMap)
+	return keys, nil
 }
 
 // ----------------------------------------------------------------
@@ -286,9 +292,6 @@ func BIF_mapsum(mlrvals []*mlrval.Mlrval) *mlrval.Mlrval {
 	if len(mlrvals) == 0 {
 		return mlrval.FromEmptyMap()
 	}
-	if len(mlrvals) == 1 {
-		return mlrvals[0]
-	}
 	if mlrvals[0].Type() != mlrval.MT_MAP {
 		return mlrval.FromNotMapError("mapsum", mlrvals[0])
 	}
@@ -312,9 +315,6 @@ func BIF_mapdiff(mlrvals []*mlrval.Mlrval) *mlrval.Mlrval {
 	if len(mlrvals) == 0 {
 		return mlrval.FromEmptyMap()
 	}
-	if len(mlrvals) == 1 {
-		return mlrvals[0]
-	}
 	if !mlrvals[0].IsMap() {
 		return mlrval.FromNotMapError("mapdiff", mlrvals[0])
 	}
@@ -451,9 +451,28 @@ func BIF_joinkv(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 // ================================================================
+// bif_split_input gets the string to be split by the split functions. Numbers
+// are split on their original string representation, so splitax(12345, "3")
+// is ["12", "45"]. The empty string splits to no pieces at all, not to one
+// empty piece.
+func bif_split_input(input1 *mlrval.Mlrval) (string, bool) {
+	if input1.IsStringOrVoid() {
+		return input1.AcquireStringValue(), true
+	} else if input1.IsNumeric() {
+		return input1.OriginalString(), true
+	} else {
+		return "", false
+	}
+}
+
+// ----------------------------------------------------------------
 // splitkv("a=3,b=4,c=5", "=", ",") -> {"a":3,"b":4,"c":5}
 func BIF_splitkv(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
-	if !input1.IsStringOrVoid() {
+	if input1.IsAbsent() {
+		return input1
+	}
+	input, ok := bif_split_input(input1)
+	if !ok {
 		return mlrval.FromNotStringError("splitkv", input1)
 	}
 	if !input2.IsString() {
@@ -467,7 +486,7 @@ func BIF_splitkv(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
 
 	output := mlrval.FromMap(mlrval.NewMlrmap())
 
-	fields := lib.SplitString(input1.AcquireStringValue(), fieldSeparator)
+	fields := lib.SplitString(input, fieldSeparator)
 	for i, field := range fields {
 		pair := strings.SplitN(field, pairSeparator, 2)
 		if len(pair) == 1 {
@@ -488,7 +507,11 @@ func BIF_splitkv(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
 // ----------------------------------------------------------------
 // splitkvx("a=3,b=4,c=5", "=", ",") -> {"a":"3","b":"4","c":"5"}
 func BIF_splitkvx(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
-	if !input1.IsStringOrVoid() {
+	if input1.IsAbsent() {
+		return input1
+	}
+	input, ok := bif_split_input(input1)
+	if !ok {
 		return mlrval.FromNotStringError("splitkvx", input1)
 	}
 	if !input2.IsString() {
@@ -502,7 +525,7 @@ func BIF_splitkvx(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
 
 	output := mlrval.FromMap(mlrval.NewMlrmap())
 
-	fields := lib.SplitString(input1.AcquireStringValue(), fieldSeparator)
+	fields := lib.SplitString(input, fieldSeparator)
 	for i, field := range fields {
 		pair := strings.SplitN(field, pairSeparator, 2)
 		if len(pair) == 1 {
@@ -524,7 +547,11 @@ func BIF_splitkvx(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
 // ----------------------------------------------------------------
 // splitnv("a,b,c", ",") -> {"1":"a","2":"b","3":"c"}
 func BIF_splitnv(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
-	if !input1.IsStringOrVoid() {
+	if input1.IsAbsent() {
+		return input1
+	}
+	input, ok := bif_split_input(input1)
+	if !ok {
 		return mlrval.FromNotStringError("splitnv", input1)
 	}
 	if !input2.IsString() {
@@ -533,7 +560,7 @@ func BIF_splitnv(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 
 	output := mlrval.FromMap(mlrval.NewMlrmap())
 
-	fields := lib.SplitString(input1.AcquireStringValue(), input2.AcquireStringValue())
+	fields := lib.SplitString(input, input2.AcquireStringValue())
 	for i, field := range fields {
 		key := strconv.Itoa(i + 1) // Miller user-space indices are 1-up
 		value := mlrval.FromInferredType(field)
@@ -546,7 +573,11 @@ func BIF_splitnv(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 // ----------------------------------------------------------------
 // splitnvx("3,4,5", ",") -> {"1":"3","2":"4","3":"5"}
 func BIF_splitnvx(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
-	if !input1.IsStringOrVoid() {
+	if input1.IsAbsent() {
+		return input1
+	}
+	input, ok := bif_split_input(input1)
+	if !ok {
 		return mlrval.FromNotStringError("splitnvx", input1)
 	}
 	if !input2.IsString() {
@@ -555,7 +586,7 @@ func BIF_splitnvx(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 
 	output := mlrval.FromMap(mlrval.NewMlrmap())
 
-	fields := lib.SplitString(input1.AcquireStringValue(), input2.AcquireStringValue())
+	fields := lib.SplitString(input, input2.AcquireStringValue())
 	for i, field := range fields {
 		key := strconv.Itoa(i + 1) // Miller user-space indices are 1-up
 		value := mlrval.FromString(field)
@@ -568,7 +599,11 @@ func BIF_splitnvx(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 // ----------------------------------------------------------------
 // splita("3,4,5", ",") -> [3,4,5]
 func BIF_splita(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
-	if !input1.IsStringOrVoid() {
+	if input1.IsAbsent() {
+		return input1
+	}
+	input, ok := bif_split_input(input1)
+	if !ok {
 		return mlrval.FromNotStringError("splita", input1)
 	}
 	if !input2.IsString() {
@@ -576,7 +611,7 @@ func BIF_splita(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	}
 	fieldSeparator := input2.AcquireStringValue()
 
-	fields := lib.SplitString(input1.AcquireStringValue(), fieldSeparator)
+	fields := lib.SplitString(input, fieldSeparator)
 
 	arrayval := make([]*mlrval.Mlrval, len(fields))
 
@@ -592,13 +627,16 @@ func BIF_splita(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 // BIF_splitax splits a string to an array, without type-inference:
 // e.g. splitax("3,4,5", ",") -> ["3","4","5"]
 func BIF_splitax(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
-	if !input1.IsStringOrVoid() {
+	if input1.IsAbsent() {
+		return input1
+	}
+	input, ok := bif_split_input(input1)
+	if !ok {
 		return mlrval.FromNotStringError("splitax", input1)
 	}
 	if !input2.IsString() {
 		return mlrval.FromNotStringError("splitax", input2)
 	}
-	input := input1.AcquireStringValue()
 	fieldSeparator := input2.AcquireStringValue()
 
 	return bif_splitax_helper(input, fieldSeparator)
@@ -16,6 +16,19 @@ func TestBIF_length(t *testing.T) {
 	assert.Equal(t, int64(1), intval)
 }
 
+func TestBIF_length_collections(t *testing.T) {
+	m := newCollectionsTestMap("a", "1", "b", "2")
+	a := mlrval.FromArray([]*mlrval.Mlrval{mlrval.FromInt(7), mlrval.FromInt(8), mlrval.FromInt(9)})
+
+	assert.Equal(t, int64(2), BIF_length(m).AcquireIntValue())
+	assert.Equal(t, int64(3), BIF_length(a).AcquireIntValue())
+	assert.Equal(t, int64(0), BIF_length(mlrval.FromEmptyMap()).AcquireIntValue())
+	assert.Equal(t, int64(0), BIF_length(mlrval.FromEmptyArray()).AcquireIntValue())
+	assert.Equal(t, int64(1), BIF_length(mlrval.FromString("abc")).AcquireIntValue())
+	assert.Equal(t, int64(1), BIF_length(mlrval.VOID).AcquireIntValue())
+	assert.Equal(t, int64(0), BIF_length(mlrval.ABSENT).AcquireIntValue())
+}
+
 func TestBIF_depth(t *testing.T) {
 	input1 := mlrval.FromInt(123)
 	output := BIF_depth(input1)
@@ -46,9 +59,6 @@ func TestBIF_depth(t *testing.T) {
 // func leafcount_from_map(input1 *mlrval.Mlrval) *mlrval.Mlrval
 // func leafcount_from_scalar(input1 *mlrval.Mlrval) *mlrval.Mlrval
 // func BIF_leafcount(input1 *mlrval.Mlrval) *mlrval.Mlrval
-// func has_key_in_array(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval
-// func has_key_in_map(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval
-// func BIF_haskey(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval
 // func BIF_mapselect(mlrvals []*mlrval.Mlrval) *mlrval.Mlrval
 // func BIF_mapexcept(mlrvals []*mlrval.Mlrval) *mlrval.Mlrval
 // func BIF_mapsum(mlrvals []*mlrval.Mlrval) *mlrval.Mlrval
@@ -63,8 +73,6 @@ func TestBIF_depth(t *testing.T) {
 // func BIF_splita(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval
 // func BIF_splitax(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval
 // func mlrvalSplitAXHelper(input string, separator string) *mlrval.Mlrval
-// func BIF_get_keys(input1 *mlrval.Mlrval) *mlrval.Mlrval
-// func BIF_get_values(input1 *mlrval.Mlrval) *mlrval.Mlrval
 // func BIF_append(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval
 // func BIF_flatten(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval
 // func BIF_flatten_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval
@@ -73,3 +81,209 @@ func TestBIF_depth(t *testing.T) {
 // func BIF_json_parse(input1 *mlrval.Mlrval) *mlrval.Mlrval
 // func BIF_json_stringify_unary(input1 *mlrval.Mlrval) *mlrval.Mlrval
 // func BIF_json_stringify_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval
+
+func TestBIF_splitax(t *testing.T) {
+	comma := mlrval.FromString(",")
+
+	output := BIF_splitax(mlrval.FromString("3,4,abc"), comma)
+	assert.True(t, output.IsArray())
+	arrayval := output.AcquireArrayValue()
+	assert.Equal(t, 3, len(arrayval))
+	assert.True(t, arrayval[0].IsStringOrVoid()) // no type inference
+	assert.Equal(t, "3", arrayval[0].String())
+	assert.Equal(t, "abc", arrayval[2].String())
+
+	// Empty pieces are kept
+	output = BIF_splitax(mlrval.FromString("a,,b"), comma)
+	assert.Equal(t, 3, len(output.AcquireArrayValue()))
+	assert.Equal(t, "", output.AcquireArrayValue()[1].String())
+
+	// The empty string splits to no pieces
+	output = BIF_splitax(mlrval.VOID, comma)
+	assert.True(t, output.IsArray())
+	assert.Equal(t, 0, len(output.AcquireArrayValue()))
+
+	// Numbers are split on their original string representation
+	output = BIF_splitax(mlrval.FromDeferredType("0x1234"), mlrval.FromString("2"))
+	assert.Equal(t, "0x1", output.AcquireArrayValue()[0].String())
+	assert.Equal(t, "34", output.AcquireArrayValue()[1].String())
+
+	assert.True(t, BIF_splitax(mlrval.ABSENT, comma).IsAbsent())
+	assert.True(t, BIF_splitax(mlrval.FromBool(true), comma).IsError())
+	assert.True(t, BIF_splitax(mlrval.FromString("a,b"), mlrval.FromInt(1)).IsError())
+}
+
+func TestBIF_splitnv(t *testing.T) {
+	comma := mlrval.FromString(",")
+
+	output := BIF_splitnv(mlrval.FromString("3,4,abc"), comma)
+	assert.True(t, output.IsMap())
+	mapval := output.AcquireMapValue()
+	assert.Equal(t, int64(3), mapval.FieldCount)
+	assert.True(t, mapval.Get("1").IsInt()) // with type inference
+	assert.Equal(t, "abc", mapval.Get("3").String())
+
+	output = BIF_splitnv(mlrval.VOID, comma)
+	assert.True(t, output.IsMap())
+	assert.Equal(t, int64(0), output.AcquireMapValue().FieldCount)
+
+	assert.True(t, BIF_splitnv(mlrval.ABSENT, comma).IsAbsent())
+	assert.True(t, BIF_splitnv(mlrval.FromEmptyMap(), comma).IsError())
+}
+
+func TestSplitJoinRoundTrips(t *testing.T) {
+	comma := mlrval.FromString(",")
+	equals := mlrval.FromString("=")
+
+	for _, input := range []string{"a,b,c", "3,4.5,abc", "a,,b", "single", ""} {
+		sinput := mlrval.FromString(input)
+
+		assert.Equal(t, input, BIF_joinv(BIF_splitax(sinput, comma), comma).String(), input)
+		assert.Equal(t, input, BIF_joinv(BIF_splita(sinput, comma), comma).String(), input)
+		assert.Equal(t, input, BIF_joinv(BIF_splitnv(sinput, comma), comma).String(), input)
+		assert.Equal(t, input, BIF_joinv(BIF_splitnvx(sinput, comma), comma).String(), input)
+	}
+
+	// Keys of splitnv are 1-up
+	output := BIF_joink(BIF_splitnv(mlrval.FromString("x,y,z"), comma), comma)
+	assert.Equal(t, "1,2,3", output.String())
+	output = BIF_joink(BIF_splitax(mlrval.FromString("x,y,z"), comma), comma)
+	assert.Equal(t, "1,2,3", output.String())
+
+	for _, input := range []string{"a=1,b=2,c=3", "a=x,b=,c=3.5", ""} {
+		sinput := mlrval.FromString(input)
+		assert.Equal(t, input, BIF_joinkv(BIF_splitkv(sinput, equals, comma), equals, comma).String(), input)
+		assert.Equal(t, input, BIF_joinkv(BIF_splitkvx(sinput, equals, comma), equals, comma).String(), input)
+	}
+
+	output = BIF_joinkv(BIF_splitax(mlrval.FromString("x,y"), comma), equals, comma)
+	assert.Equal(t, "1=x,2=y", output.String())
+
+	assert.True(t, BIF_joinv(mlrval.FromString("abc"), comma).IsError())
+	assert.True(t, BIF_joink(mlrval.FromEmptyMap(), mlrval.FromInt(1)).IsError())
+}
+
+func newCollectionsTestMap(keysAndValues ...string) *mlrval.Mlrval {
+	mlrmap := mlrval.NewMlrmap()
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		mlrmap.PutReference(keysAndValues[i], mlrval.FromString(keysAndValues[i+1]))
+	}
+	return mlrval.FromMap(mlrmap)
+}
+
+func TestBIF_mapsum(t *testing.T) {
+	m1 := newCollectionsTestMap("a", "1", "b", "2", "c", "3")
+	m2 := newCollectionsTestMap("d", "4", "b", "5")
+	m3 := newCollectionsTestMap("a", "6")
+
+	// Rightmost collisions win; keys keep their first-seen order
+	output := BIF_mapsum([]*mlrval.Mlrval{m1, m2, m3})
+	assert.True(t, output.IsMap())
+	assert.Equal(t, "a=6,b=5,c=3,d=4", output.AcquireMapValue().ToDKVPString())
+	// Inputs are not modified
+	assert.Equal(t, "a=1,b=2,c=3", m1.AcquireMapValue().ToDKVPString())
+
+	assert.Equal(t, int64(0), BIF_mapsum([]*mlrval.Mlrval{}).AcquireMapValue().FieldCount)
+	assert.Equal(t, "a=1,b=2,c=3", BIF_mapsum([]*mlrval.Mlrval{m1}).AcquireMapValue().ToDKVPString())
+	assert.True(t, BIF_mapsum([]*mlrval.Mlrval{mlrval.FromInt(3)}).IsError())
+	assert.True(t, BIF_mapsum([]*mlrval.Mlrval{m1, mlrval.FromInt(3)}).IsError())
+}
+
+func TestBIF_mapdiff(t *testing.T) {
+	m1 := newCollectionsTestMap("a", "1", "b", "2", "c", "3")
+	m2 := newCollectionsTestMap("b", "x", "nosuch", "y")
+	m3 := newCollectionsTestMap("a", "z")
+
+	output := BIF_mapdiff([]*mlrval.Mlrval{m1, m2})
+	assert.Equal(t, "a=1,c=3", output.AcquireMapValue().ToDKVPString())
+	output = BIF_mapdiff([]*mlrval.Mlrval{m1, m2, m3})
+	assert.Equal(t, "c=3", output.AcquireMapValue().ToDKVPString())
+	assert.Equal(t, "a=1,b=2,c=3", m1.AcquireMapValue().ToDKVPString())
+
+	assert.Equal(t, "a=1,b=2,c=3", BIF_mapdiff([]*mlrval.Mlrval{m1}).AcquireMapValue().ToDKVPString())
+	assert.True(t, BIF_mapdiff([]*mlrval.Mlrval{mlrval.FromString("x")}).IsError())
+	assert.True(t, BIF_mapdiff([]*mlrval.Mlrval{m1, mlrval.FromString("x")}).IsError())
+}
+
+func TestBIF_mapexcept(t *testing.T) {
+	m := newCollectionsTestMap("1", "2", "3", "4", "5", "6", "x", "7")
+
+	output := BIF_mapexcept([]*mlrval.Mlrval{m, mlrval.FromInt(1), mlrval.FromString("x"), mlrval.FromInt(7)})
+	assert.Equal(t, "3=4,5=6", output.AcquireMapValue().ToDKVPString())
+
+	keys := mlrval.FromArray([]*mlrval.Mlrval{mlrval.FromInt(1), mlrval.FromInt(5), mlrval.FromString("x")})
+	output = BIF_mapexcept([]*mlrval.Mlrval{m, keys})
+	assert.Equal(t, "3=4", output.AcquireMapValue().ToDKVPString())
+
+	output = BIF_mapexcept([]*mlrval.Mlrval{m})
+	assert.Equal(t, "1=2,3=4,5=6,x=7", output.AcquireMapValue().ToDKVPString())
+
+	assert.True(t, BIF_mapexcept([]*mlrval.Mlrval{m, mlrval.FromFloat(1.5)}).IsError())
+	badKeys := mlrval.FromArray([]*mlrval.Mlrval{mlrval.FromFloat(1.5)})
+	assert.True(t, BIF_mapexcept([]*mlrval.Mlrval{m, badKeys}).IsError())
+	assert.True(t, BIF_mapexcept([]*mlrval.Mlrval{mlrval.FromInt(1)}).IsError())
+}
+
+func TestBIF_mapselect(t *testing.T) {
+	m := newCollectionsTestMap("c", "1", "b", "2", "a", "3")
+
+	// Order is from the map, not from the argument list
+	output := BIF_mapselect([]*mlrval.Mlrval{m, mlrval.FromString("a"), mlrval.FromString("c"), mlrval.FromString("z")})
+	assert.Equal(t, "c=1,a=3", output.AcquireMapValue().ToDKVPString())
+
+	keys := mlrval.FromArray([]*mlrval.Mlrval{mlrval.FromString("a"), mlrval.FromString("b")})
+	output = BIF_maponly([]*mlrval.Mlrval{m, keys})
+	assert.Equal(t, "b=2,a=3", output.AcquireMapValue().ToDKVPString())
+
+	numeric := newCollectionsTestMap("1", "2", "3", "4", "5", "6")
+	keys = mlrval.FromArray([]*mlrval.Mlrval{mlrval.FromInt(1), mlrval.FromInt(5), mlrval.FromInt(7)})
+	output = BIF_mapselect([]*mlrval.Mlrval{numeric, keys})
+	assert.Equal(t, "1=2,5=6", output.AcquireMapValue().ToDKVPString())
+
+	assert.Equal(t, int64(0), BIF_maponly([]*mlrval.Mlrval{m}).AcquireMapValue().FieldCount)
+	assert.True(t, BIF_maponly([]*mlrval.Mlrval{m, mlrval.FromBool(true)}).IsError())
+	assert.True(t, BIF_maponly([]*mlrval.Mlrval{mlrval.FromString("abc")}).IsError())
+}
+
+func TestBIF_get_keys_and_values(t *testing.T) {
+	m := newCollectionsTestMap("c", "x", "a", "y")
+	a := mlrval.FromArray([]*mlrval.Mlrval{mlrval.FromString("p"), mlrval.FromString("q")})
+
+	// Map keys are in insertion order; array keys are 1-up indices
+	assert.Equal(t, `["c", "a"]`, BIF_get_keys(m).String())
+	assert.Equal(t, "[1, 2]", BIF_get_keys(a).String())
+	assert.Equal(t, `["x", "y"]`, BIF_get_values(m).String())
+	assert.Equal(t, `["p", "q"]`, BIF_get_values(a).String())
+	assert.Equal(t, "[]", BIF_get_keys(mlrval.FromEmptyMap()).String())
+
+	// Values are copies
+	values := BIF_get_values(a)
+	values.AcquireArrayValue()[0] = mlrval.FromString("changed")
+	assert.Equal(t, "p", a.AcquireArrayValue()[0].String())
+
+	assert.True(t, BIF_get_keys(mlrval.FromInt(1)).IsError())
+	assert.True(t, BIF_get_values(mlrval.FromString("abc")).IsError())
+}
+
+func TestBIF_haskey(t *testing.T) {
+	m := newCollectionsTestMap("a", "1", "3", "2")
+	a := mlrval.FromArray([]*mlrval.Mlrval{mlrval.FromInt(7), mlrval.FromInt(8), mlrval.FromInt(9)})
+
+	assert.True(t, BIF_haskey(m, mlrval.FromString("a")).AcquireBoolValue())
+	assert.False(t, BIF_haskey(m, mlrval.FromString("b")).AcquireBoolValue())
+	// Int keys match their string representation
+	assert.True(t, BIF_haskey(m, mlrval.FromInt(3)).AcquireBoolValue())
+	assert.True(t, BIF_haskey(m, mlrval.FromFloat(1.5)).IsError())
+
+	// Arrays are 1-up, with -n..-1 aliasing 1..n
+	for _, index := range []int64{1, 3, -1, -3} {
+		assert.True(t, BIF_haskey(a, mlrval.FromInt(index)).AcquireBoolValue(), "index %d", index)
+	}
+	for _, index := range []int64{0, 4, -4} {
+		assert.False(t, BIF_haskey(a, mlrval.FromInt(index)).AcquireBoolValue(), "index %d", index)
+	}
+	assert.False(t, BIF_haskey(a, mlrval.FromString("a")).AcquireBoolValue())
+
+	assert.True(t, BIF_haskey(mlrval.FromString("abc"), mlrval.FromInt(1)).IsError())
+	assert.True(t, BIF_haskey(mlrval.ABSENT, mlrval.FromInt(1)).IsError())
+}
@@ -311,6 +311,9 @@ func strftimeHelper(
 	if !input2.IsString() {
 		return mlrval.FromNotStringError(funcname, input2)
 	}
+	if _, ok := lib.FormatNonFiniteFloat(epochSeconds); ok {
+		return input1
+	}
 
 	// Convert argument1 from float seconds since the epoch to a Go time.
 	var inputTime time.Time
@@ -430,7 +433,7 @@ func init() {
 		return specificationHelper(b, t, "%07d", 100)
 	})
 	appender8 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
-		return specificationHelper(b, t, "%09d", 10)
+		return specificationHelper(b, t, "%08d", 10)
 	})
 	appender9 := strftime.AppendFunc(func(b []byte, t time.Time) []byte {
 		return specificationHelper(b, t, "%09d", 1)
@@ -470,6 +473,20 @@ func init() {
 	strftimeExtensions = strftime.WithSpecificationSet(ss)
 }
 
+// getStrptimeInput returns the string to be parsed by the strptime family.
+// Field values like 20230115 or 2023 are type-inferred as numbers, but with
+// formats like "%Y%m%d" or "%Y" they are still timestamps, so numbers are
+// parsed from their original string representation.
+func getStrptimeInput(input1 *mlrval.Mlrval, funcname string) (string, *mlrval.Mlrval) {
+	if input1.IsString() {
+		return input1.AcquireStringValue(), nil
+	}
+	if input1.IsNumeric() {
+		return input1.String(), nil
+	}
+	return "", mlrval.FromNotStringError(funcname, input1)
+}
+
 // ================================================================
 // Argument 1 is formatted date string like "2021-03-04 02:59:50".
 // Argument 2 is format string like "%Y-%m-%d %H:%M:%S".
@@ -482,13 +499,17 @@ func BIF_strpntime(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 func bif_strptime_unary_aux(input1, input2 *mlrval.Mlrval, doLocal, produceNanoseconds bool) *mlrval.Mlrval {
-	if !input1.IsString() {
-		return mlrval.FromNotStringError("strptime", input1)
+	funcname := "strptime"
+	if produceNanoseconds {
+		funcname = "strpntime"
+	}
+	timeString, errValue := getStrptimeInput(input1, funcname)
+	if errValue != nil {
+		return errValue
 	}
 	if !input2.IsString() {
-		return mlrval.FromNotStringError("strptime", input2)
+		return mlrval.FromNotStringError(funcname, input2)
 	}
-	timeString := input1.AcquireStringValue()
 	formatString := input2.AcquireStringValue()
 
 	var t time.Time
@@ -505,7 +526,7 @@ func bif_strptime_unary_aux(input1, input2 *mlrval.Mlrval, doLocal, produceNanos
 	if produceNanoseconds {
 		return mlrval.FromInt(t.UnixNano())
 	} else {
-		return mlrval.FromFloat(float64(t.UnixNano()) / 1.0e9)
+		return mlrval.FromFloat(lib.TimeToEpochSeconds(t))
 	}
 }
 
@@ -538,13 +559,17 @@ func BIF_strpntime_local_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 func bif_strptime_binary_aux(input1, input2 *mlrval.Mlrval, doLocal, produceNanoseconds bool) *mlrval.Mlrval {
-	if !input1.IsString() {
-		return mlrval.FromNotStringError("strptime", input1)
+	funcname := "strptime"
+	if produceNanoseconds {
+		funcname = "strpntime"
+	}
+	timeString, errValue := getStrptimeInput(input1, funcname)
+	if errValue != nil {
+		return errValue
 	}
 	if !input2.IsString() {
-		return mlrval.FromNotStringError("strptime", input2)
+		return mlrval.FromNotStringError(funcname, input2)
 	}
-	timeString := input1.AcquireStringValue()
 	formatString := input2.AcquireStringValue()
 
 	var t time.Time
@@ -561,7 +586,7 @@ func bif_strptime_binary_aux(input1, input2 *mlrval.Mlrval, doLocal, produceNano
 	if produceNanoseconds {
 		return mlrval.FromInt(t.UnixNano())
 	} else {
-		return mlrval.FromFloat(float64(t.UnixNano()) / 1.0e9)
+		return mlrval.FromFloat(lib.TimeToEpochSeconds(t))
 	}
 }
 
@@ -584,17 +609,21 @@ func BIF_localtime2nsec_binary(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 func bif_strptime_local_ternary_aux(input1, input2, input3 *mlrval.Mlrval, produceNanoseconds bool) *mlrval.Mlrval {
-	if !input1.IsString() {
-		return mlrval.FromNotStringError("strptime_local", input1)
+	funcname := "strptime_local"
+	if produceNanoseconds {
+		funcname = "strpntime_local"
+	}
+	timeString, errValue := getStrptimeInput(input1, funcname)
+	if errValue != nil {
+		return errValue
 	}
 	if !input2.IsString() {
-		return mlrval.FromNotStringError("strptime_local", input2)
+		return mlrval.FromNotStringError(funcname, input2)
 	}
 	if !input3.IsString() {
-		return mlrval.FromNotStringError("strptime_local", input3)
+		return mlrval.FromNotStringError(funcname, input3)
 	}
 
-	timeString := input1.AcquireStringValue()
 	formatString := input2.AcquireStringValue()
 	locationString := input3.AcquireStringValue()
 
@@ -611,6 +640,6 @@ func bif_strptime_local_ternary_aux(input1, input2, input3 *mlrval.Mlrval, produ
 	if produceNanoseconds {
 		return mlrval.FromInt(t.UnixNano())
 	} else {
-		return mlrval.FromFloat(float64(t.UnixNano()) / 1.0e9)
+		return mlrval.FromFloat(lib.TimeToEpochSeconds(t))
 	}
 }
@@ -0,0 +1,169 @@
+package bifs
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnkerl/miller/pkg/mlrval"
+)
+
+func TestBIF_strftime(t *testing.T) {
+	format := mlrval.FromString("%Y-%m-%d %H:%M:%S")
+	assert.Equal(t, "1970-01-01 00:00:00", BIF_strftime(mlrval.FromInt(0), format).String())
+	assert.Equal(t, "2017-07-14 02:40:00", BIF_strftime(mlrval.FromInt(1500000000), format).String())
+	// Fractional seconds are truncated by %S
+	assert.Equal(t, "2017-07-14 02:40:00", BIF_strftime(mlrval.FromFloat(1500000000.75), format).String())
+
+	cases := []struct {
+		format   string
+		expected string
+	}{
+		{"%1S", "01.1"},
+		{"%3S", "01.123"},
+		{"%6S", "01.123456"},
+		{"%7S", "01.1234567"},
+		{"%8S", "01.12345678"},
+		{"%9S", "01.123456789"},
+	}
+	// Near the epoch, float seconds are precise enough for all nine places
+	input := mlrval.FromFloat(1.123456789)
+	for _, c := range cases {
+		output := BIF_strftime(input, mlrval.FromString(c.format))
+		assert.Equal(t, c.expected, output.String(), c.format)
+	}
+	output := BIF_strfntime(mlrval.FromInt(1500000000123456789), mlrval.FromString("%H:%M:%8S"))
+	assert.Equal(t, "02:40:00.12345678", output.String())
+
+	assert.True(t, BIF_strftime(mlrval.FromString("abc"), format).IsError())
+	assert.True(t, BIF_strftime(mlrval.FromInt(0), mlrval.FromInt(1)).IsError())
+	assert.True(t, BIF_strftime(mlrval.VOID, format).IsVoid())
+}
+
+func TestBIF_strftime_local(t *testing.T) {
+	format := mlrval.FromString("%Y-%m-%d %H:%M:%S %Z")
+	output := BIF_strftime_local_ternary(mlrval.FromInt(0), format, mlrval.FromString("Asia/Istanbul"))
+	assert.Equal(t, "1970-01-01 02:00:00 EET", output.String())
+	output = BIF_strftime_local_ternary(mlrval.FromInt(0), format, mlrval.FromString("Nowhere/Nosuch"))
+	assert.True(t, output.IsError())
+}
+
+func TestBIF_strptime(t *testing.T) {
+	format := mlrval.FromString("%Y-%m-%dT%H:%M:%SZ")
+
+	output := BIF_strptime(mlrval.FromString("2019-01-02T03:04:05Z"), format)
+	floatval, ok := output.GetNumericToFloatValue()
+	assert.True(t, ok)
+	assert.Equal(t, 1546398245.0, floatval)
+
+	output = BIF_strptime(mlrval.FromString("2019-01-02T03:04:05.25Z"), format)
+	floatval, ok = output.GetNumericToFloatValue()
+	assert.True(t, ok)
+	assert.Equal(t, 1546398245.25, floatval)
+
+	output = BIF_strptime(mlrval.FromString("1969-12-31T23:59:58.5Z"), format)
+	floatval, _ = output.GetNumericToFloatValue()
+	assert.Equal(t, -1.5, floatval)
+
+	output = BIF_strpntime(mlrval.FromString("2019-01-02T03:04:05.25Z"), format)
+	intval, ok := output.GetIntValue()
+	assert.True(t, ok)
+	assert.Equal(t, int64(1546398245250000000), intval)
+
+	output = BIF_strptime_local_ternary(
+		mlrval.FromString("1970-01-01 02:00:00"),
+		mlrval.FromString("%Y-%m-%d %H:%M:%S"),
+		mlrval.FromString("Asia/Istanbul"),
+	)
+	floatval, _ = output.GetNumericToFloatValue()
+	assert.Equal(t, 0.0, floatval)
+
+	assert.True(t, BIF_strptime(mlrval.FromString("nosuch"), format).IsError())
+	assert.True(t, BIF_strptime(mlrval.FromString("2019-01-02"), format).IsError())
+	assert.True(t, BIF_strptime(mlrval.FromInt(3), format).IsError())
+}
+
+func TestBIF_strptime_numeric_input(t *testing.T) {
+	// As from data fields, which are type-inferred
+	output := BIF_strptime(mlrval.FromDeferredType("20230115"), mlrval.FromString("%Y%m%d"))
+	floatval, ok := output.GetNumericToFloatValue()
+	assert.True(t, ok)
+	assert.Equal(t, 1673740800.0, floatval)
+
+	output = BIF_strpntime(mlrval.FromInt(2023), mlrval.FromString("%Y"))
+	nanos, ok := output.GetIntValue()
+	assert.True(t, ok)
+	assert.Equal(t, int64(1672531200000000000), nanos)
+
+	output = BIF_strptime_local_ternary(
+		mlrval.FromInt(2023), mlrval.FromString("%Y"), mlrval.FromString("Asia/Tokyo"),
+	)
+	floatval, _ = output.GetNumericToFloatValue()
+	assert.Equal(t, 1672498800.0, floatval)
+
+	assert.True(t, BIF_strptime(mlrval.FromBool(true), mlrval.FromString("%Y")).IsError())
+}
+
+func TestStrfntimeStrpntimeNanoseconds(t *testing.T) {
+	cases := []struct {
+		nanos    int64
+		expected string
+	}{
+		{1500000000123456789, "2017-07-14T02:40:00.123456789Z"},
+		{0, "1970-01-01T00:00:00.000000000Z"},
+		{-1, "1969-12-31T23:59:59.999999999Z"},
+		{-1500000000123456789, "1922-06-20T21:19:59.876543211Z"},
+	}
+	for _, c := range cases {
+		formatted := BIF_strfntime(mlrval.FromInt(c.nanos), mlrval.FromString("%Y-%m-%dT%H:%M:%9SZ"))
+		assert.Equal(t, c.expected, formatted.String())
+
+		// Nanoseconds survive the round trip exactly, which float seconds would not
+		parsed := BIF_strpntime(formatted, mlrval.FromString("%Y-%m-%dT%H:%M:%SZ"))
+		nanos, ok := parsed.GetIntValue()
+		assert.True(t, ok, c.expected)
+		assert.Equal(t, c.nanos, nanos, c.expected)
+	}
+
+	assert.Equal(t, "123456789", BIF_strfntime(mlrval.FromInt(1500000000123456789), mlrval.FromString("%N")).String())
+	assert.Equal(t, "1500000000", BIF_strfntime(mlrval.FromInt(1500000000123456789), mlrval.FromString("%s")).String())
+	assert.True(t, BIF_strfntime(mlrval.FromFloat(1.5), mlrval.FromString("%s")).IsError())
+}
+
+func TestStrftimeStrptimeRoundTrip(t *testing.T) {
+	format := mlrval.FromString("%Y-%m-%d %H:%M:%6S")
+	for _, seconds := range []float64{0.0, 1.5, 1500000000.25, 2000000000.125, -86400.5} {
+		formatted := BIF_strftime(mlrval.FromFloat(seconds), format)
+		parsed := BIF_strptime(formatted, mlrval.FromString("%Y-%m-%d %H:%M:%S"))
+		floatval, ok := parsed.GetNumericToFloatValue()
+		assert.True(t, ok, formatted.String())
+		assert.Equal(t, seconds, floatval, formatted.String())
+	}
+
+	format = mlrval.FromString("%Y-%m-%dT%H:%M:%SZ")
+	input := mlrval.FromString("2023-06-15T12:34:56Z")
+	output := BIF_strftime(BIF_strptime(input, format), format)
+	assert.Equal(t, input.String(), output.String())
+}
+
+func TestTimeFunctionsOnNonFiniteFloats(t *testing.T) {
+	format := mlrval.FromString("%Y-%m-%d")
+	cases := []struct {
+		input    float64
+		expected string
+	}{
+		{math.NaN(), "NaN"},
+		{math.Inf(1), "+Inf"},
+		{math.Inf(-1), "-Inf"},
+	}
+	for _, c := range cases {
+		input := mlrval.FromFloat(c.input)
+		assert.Equal(t, c.expected, BIF_sec2gmt_unary(input).String())
+		assert.Equal(t, c.expected, BIF_sec2gmt_binary(input, mlrval.FromInt(3)).String())
+		assert.Equal(t, c.expected, BIF_sec2gmtdate(input).String())
+		assert.Equal(t, c.expected, BIF_strftime(input, format).String())
+		assert.Equal(t, c.expected, BIF_fsec2hms(input).String())
+		assert.Equal(t, c.expected, BIF_fsec2dhms(input).String())
+	}
+}
@@ -77,7 +77,7 @@ func BIF_atanh(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mudispo[input1.Type()](input1, math.Atanh, "atanh")
 }
 func BIF_cbrt(input1 *mlrval.Mlrval) *mlrval.Mlrval {
-	return mudispo[input1.Type()](input1, math.Cbrt, "atan")
+	return mudispo[input1.Type()](input1, math.Cbrt, "cbrt")
 }
 func BIF_cos(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mudispo[input1.Type()](input1, math.Cos, "cos")
@@ -221,6 +221,15 @@ func atan2_f_ff(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromFloat(math.Atan2(input1.AcquireFloatValue(), input2.AcquireFloatValue()))
 }
 
+// Absent first argument acts like zero, as with division. Note atan2(0, x) is
+// pi, not zero, for negative x.
+func atan2_f_ai(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromFloat(math.Atan2(0, float64(input2.AcquireIntValue())))
+}
+func atan2_f_af(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
+	return mlrval.FromFloat(math.Atan2(0, input2.AcquireFloatValue()))
+}
+
 func atan2te(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromTypeErrorBinary("atan2", input1, input2)
 }
@@ -237,7 +246,7 @@ var atan2_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
 	/*FUNC   */ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn},
 	/*ERROR  */ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn},
 	/*NULL   */ {atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, atan2te, _absn},
-	/*ABSENT */ {_i0__, _f0__, atan2te, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _absn},
+	/*ABSENT */ {atan2_f_ai, atan2_f_af, atan2te, _absn, _absn, _absn, _absn, _absn, _absn, _absn, _absn},
 }
 
 func BIF_atan2(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
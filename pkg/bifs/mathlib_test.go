@@ -0,0 +1,186 @@
+package bifs
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnkerl/miller/pkg/mlrval"
+)
+
+func TestMathLibUnary(t *testing.T) {
+	cases := []struct {
+		name string
+		bif  UnaryFunc
+		f    func(float64) float64
+	}{
+		{"sqrt", BIF_sqrt, math.Sqrt},
+		{"cbrt", BIF_cbrt, math.Cbrt},
+		{"exp", BIF_exp, math.Exp},
+		{"expm1", BIF_expm1, math.Expm1},
+		{"log", BIF_log, math.Log},
+		{"log10", BIF_log10, math.Log10},
+		{"log1p", BIF_log1p, math.Log1p},
+		{"sin", BIF_sin, math.Sin},
+		{"cos", BIF_cos, math.Cos},
+		{"tan", BIF_tan, math.Tan},
+		{"asin", BIF_asin, math.Asin},
+		{"acos", BIF_acos, math.Acos},
+		{"atan", BIF_atan, math.Atan},
+		{"sinh", BIF_sinh, math.Sinh},
+		{"cosh", BIF_cosh, math.Cosh},
+		{"tanh", BIF_tanh, math.Tanh},
+	}
+	inputs := []*mlrval.Mlrval{
+		mlrval.FromInt(0),
+		mlrval.FromInt(1),
+		mlrval.FromInt(-1),
+		mlrval.FromInt(1000),
+		mlrval.FromFloat(0.5),
+		mlrval.FromFloat(-2.25),
+		mlrval.FromDeferredType("0x10"),
+	}
+
+	for _, c := range cases {
+		for _, input := range inputs {
+			x, _ := input.GetNumericToFloatValue()
+			expected := c.f(x)
+
+			output := c.bif(input)
+			assert.True(t, output.IsFloat(), "%s(%s)", c.name, input.String())
+			actual, _ := output.GetFloatValue()
+			if math.IsNaN(expected) {
+				assert.True(t, math.IsNaN(actual), "%s(%s)", c.name, input.String())
+			} else {
+				assert.Equal(t, expected, actual, "%s(%s)", c.name, input.String())
+			}
+		}
+
+		assert.True(t, c.bif(mlrval.FromString("abc")).IsError(), c.name)
+		assert.True(t, c.bif(mlrval.FromBool(true)).IsError(), c.name)
+		assert.True(t, c.bif(mlrval.ABSENT).IsAbsent(), c.name)
+		assert.True(t, c.bif(mlrval.VOID).IsVoid(), c.name)
+	}
+}
+
+func TestMathLibSqrtOfNegative(t *testing.T) {
+	output := BIF_sqrt(mlrval.FromInt(-4))
+	assert.True(t, output.IsFloat())
+	floatval, _ := output.GetFloatValue()
+	assert.True(t, math.IsNaN(floatval))
+}
+
+func TestTrigKnownValues(t *testing.T) {
+	cases := []struct {
+		name     string
+		output   *mlrval.Mlrval
+		expected float64
+	}{
+		{"sin(0)", BIF_sin(mlrval.FromInt(0)), 0.0},
+		{"cos(0)", BIF_cos(mlrval.FromInt(0)), 1.0},
+		{"tan(0)", BIF_tan(mlrval.FromInt(0)), 0.0},
+		{"asin(1)", BIF_asin(mlrval.FromInt(1)), math.Pi / 2},
+		{"acos(-1)", BIF_acos(mlrval.FromInt(-1)), math.Pi},
+		{"atan(1)", BIF_atan(mlrval.FromInt(1)), math.Pi / 4},
+		{"tanh(0)", BIF_tanh(mlrval.FromFloat(0.0)), 0.0},
+		{"cosh(0)", BIF_cosh(mlrval.FromFloat(0.0)), 1.0},
+		{"atan2(1,1)", BIF_atan2(mlrval.FromInt(1), mlrval.FromInt(1)), math.Pi / 4},
+		{"atan2(1,-1.0)", BIF_atan2(mlrval.FromInt(1), mlrval.FromFloat(-1.0)), 3 * math.Pi / 4},
+		{"atan2(-1.0,0)", BIF_atan2(mlrval.FromFloat(-1.0), mlrval.FromInt(0)), -math.Pi / 2},
+		{"atan2(absent,1)", BIF_atan2(mlrval.ABSENT, mlrval.FromInt(1)), 0.0},
+		{"atan2(absent,-1)", BIF_atan2(mlrval.ABSENT, mlrval.FromInt(-1)), math.Pi},
+	}
+	for _, c := range cases {
+		assert.True(t, c.output.IsFloat(), c.name)
+		actual, _ := c.output.GetFloatValue()
+		assert.InDelta(t, c.expected, actual, 1e-15, c.name)
+	}
+}
+
+func TestBIF_atan2NonNumeric(t *testing.T) {
+	one := mlrval.FromInt(1)
+	assert.True(t, BIF_atan2(mlrval.FromString("abc"), one).IsError())
+	assert.True(t, BIF_atan2(one, mlrval.FromBool(true)).IsError())
+	assert.True(t, BIF_atan2(mlrval.VOID, one).IsVoid())
+	assert.True(t, BIF_atan2(one, mlrval.VOID).IsVoid())
+	assert.True(t, BIF_atan2(mlrval.ABSENT, mlrval.ABSENT).IsAbsent())
+}
+
+func TestBIF_pow(t *testing.T) {
+	output := BIF_pow(mlrval.FromInt(2), mlrval.FromInt(10))
+	intval, ok := output.GetIntValue()
+	assert.True(t, ok)
+	assert.Equal(t, int64(1024), intval)
+
+	// Not exact as int, so float
+	output = BIF_pow(mlrval.FromInt(2), mlrval.FromInt(-1))
+	floatval, ok := output.GetFloatValue()
+	assert.True(t, ok)
+	assert.Equal(t, 0.5, floatval)
+
+	output = BIF_pow(mlrval.FromFloat(2.5), mlrval.FromFloat(1.5))
+	floatval, ok = output.GetFloatValue()
+	assert.True(t, ok)
+	assert.Equal(t, math.Pow(2.5, 1.5), floatval)
+
+	assert.True(t, BIF_pow(mlrval.FromString("abc"), mlrval.FromInt(2)).IsError())
+	assert.Equal(t, "3", BIF_pow(mlrval.FromInt(3), mlrval.ABSENT).String())
+}
+
+func TestBIF_qnorm(t *testing.T) {
+	output := BIF_qnorm(mlrval.FromInt(0))
+	floatval, ok := output.GetFloatValue()
+	assert.True(t, ok)
+	assert.InDelta(t, 0.5, floatval, 1e-15)
+
+	output = BIF_qnorm(mlrval.FromFloat(1.959963984540054))
+	floatval, _ = output.GetFloatValue()
+	assert.InDelta(t, 0.975, floatval, 1e-12)
+
+	assert.True(t, BIF_qnorm(mlrval.FromString("abc")).IsError())
+	assert.True(t, BIF_qnorm(mlrval.ABSENT).IsAbsent())
+}
+
+func TestBIF_invqnorm(t *testing.T) {
+	output := BIF_invqnorm(mlrval.FromFloat(0.5))
+	floatval, ok := output.GetFloatValue()
+	assert.True(t, ok)
+	assert.InDelta(t, 0.0, floatval, 1e-9)
+
+	output = BIF_invqnorm(mlrval.FromFloat(0.975))
+	floatval, _ = output.GetFloatValue()
+	assert.InDelta(t, 1.959963984540054, floatval, 1e-6)
+
+	// The documented error bound is on qnorm of the output
+	for _, p := range []float64{0.001, 0.1, 0.25, 0.5, 0.75, 0.9, 0.999} {
+		y, _ := BIF_invqnorm(mlrval.FromFloat(p)).GetFloatValue()
+		x, _ := BIF_qnorm(mlrval.FromFloat(y)).GetFloatValue()
+		assert.InDelta(t, p, x, 1e-9, "p=%v", p)
+	}
+
+	// Out of range
+	for _, p := range []float64{0.0, 1.0, -1.0, 2.0} {
+		y, _ := BIF_invqnorm(mlrval.FromFloat(p)).GetFloatValue()
+		assert.Equal(t, 0.0, y, "p=%v", p)
+	}
+
+	assert.True(t, BIF_invqnorm(mlrval.FromString("abc")).IsError())
+	assert.True(t, BIF_invqnorm(mlrval.ABSENT).IsAbsent())
+}
+
+func TestBIF_logifit(t *testing.T) {
+	output := BIF_logifit(mlrval.FromInt(2), mlrval.FromInt(3), mlrval.FromInt(-6))
+	floatval, ok := output.GetFloatValue()
+	assert.True(t, ok)
+	assert.Equal(t, 0.5, floatval)
+
+	output = BIF_logifit(mlrval.FromFloat(1.5), mlrval.FromFloat(0.5), mlrval.FromFloat(0.25))
+	floatval, _ = output.GetFloatValue()
+	assert.InDelta(t, 1.0/(1.0+math.Exp(-1.0)), floatval, 1e-15)
+
+	one := mlrval.FromInt(1)
+	assert.True(t, BIF_logifit(mlrval.FromString("abc"), one, one).IsError())
+	assert.True(t, BIF_logifit(one, one, mlrval.FromBool(true)).IsError())
+	assert.True(t, BIF_logifit(one, mlrval.ABSENT, one).IsAbsent())
+}
@@ -1,6 +1,7 @@
 package bifs
 
 import (
+	"fmt"
 	"math"
 
 	"github.com/johnkerl/miller/pkg/lib"
@@ -38,17 +39,14 @@ func BIF_urandint(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 
 	a := input1.AcquireIntValue()
 	b := input2.AcquireIntValue()
-
-	var lo int64 = 0
-	var hi int64 = 0
-	if a <= b {
-		lo = a
-		hi = b + 1
-	} else {
-		lo = b
-		hi = a + 1
+	if a > b {
+		return mlrval.FromError(
+			fmt.Errorf("urandint: lower bound %d exceeds upper bound %d", a, b),
+		)
 	}
-	u := int64(math.Floor(float64(lo) + float64((hi-lo))*lib.RandFloat64()))
+
+	// Inclusive on both ends
+	u := int64(math.Floor(float64(a) + float64(b-a+1)*lib.RandFloat64()))
 	return mlrval.FromInt(u)
 }
 
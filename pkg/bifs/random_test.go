@@ -0,0 +1,69 @@
+package bifs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnkerl/miller/pkg/lib"
+	"github.com/johnkerl/miller/pkg/mlrval"
+)
+
+// draw returns one value from each of the random functions.
+func draw() []string {
+	return []string{
+		BIF_urand().String(),
+		BIF_urand32().String(),
+		BIF_urandint(mlrval.FromInt(1), mlrval.FromInt(6)).String(),
+		BIF_urandrange(mlrval.FromFloat(-1.5), mlrval.FromInt(1)).String(),
+		BIF_urandelement(mlrval.FromArray([]*mlrval.Mlrval{
+			mlrval.FromString("a"), mlrval.FromString("b"),
+		})).String(),
+	}
+}
+
+func TestRandomFunctionsAreSeeded(t *testing.T) {
+	lib.SeedRandom(12345)
+	first := [][]string{draw(), draw(), draw()}
+	lib.SeedRandom(12345)
+	second := [][]string{draw(), draw(), draw()}
+	assert.Equal(t, first, second)
+}
+
+func TestBIF_urand(t *testing.T) {
+	lib.SeedRandom(1)
+	for i := 0; i < 100; i++ {
+		output := BIF_urand()
+		floatval, ok := output.GetFloatValue()
+		assert.True(t, ok)
+		assert.True(t, floatval >= 0.0 && floatval < 1.0)
+
+		output = BIF_urand32()
+		intval, ok := output.GetIntValue()
+		assert.True(t, ok)
+		assert.True(t, intval >= 0 && intval <= 0xffffffff)
+
+		output = BIF_urandrange(mlrval.FromInt(3), mlrval.FromFloat(3.5))
+		floatval, ok = output.GetFloatValue()
+		assert.True(t, ok)
+		assert.True(t, floatval >= 3.0 && floatval < 3.5)
+	}
+}
+
+func TestBIF_urandint(t *testing.T) {
+	lib.SeedRandom(1)
+	seen := make(map[int64]bool)
+	for i := 0; i < 200; i++ {
+		intval, ok := BIF_urandint(mlrval.FromInt(-2), mlrval.FromInt(2)).GetIntValue()
+		assert.True(t, ok)
+		assert.True(t, intval >= -2 && intval <= 2)
+		seen[intval] = true
+	}
+	// Both endpoints are included
+	assert.Equal(t, 5, len(seen))
+
+	assert.Equal(t, "7", BIF_urandint(mlrval.FromInt(7), mlrval.FromInt(7)).String())
+	assert.True(t, BIF_urandint(mlrval.FromInt(7), mlrval.FromInt(6)).IsError())
+	assert.True(t, BIF_urandint(mlrval.FromFloat(1.5), mlrval.FromInt(6)).IsError())
+	assert.True(t, BIF_urandint(mlrval.ABSENT, mlrval.FromInt(6)).IsAbsent())
+}
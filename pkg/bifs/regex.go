@@ -185,6 +185,10 @@ func BIF_strmatchx(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 
 // BIF_string_matches_regexp implements the =~ operator, with support for
 // setting regex-captures for later expressions to access using "\1" .. "\9".
+// Non-string left-hand sides are matched using their string representation,
+// so 0xff =~ "^0x" is true. Regexes are compiled once per distinct pattern
+// string (see lib.CompileMillerRegex), including the "..."i case-insensitive
+// form.
 func BIF_string_matches_regexp(input1, input2 *mlrval.Mlrval) (retval *mlrval.Mlrval, captures []string) {
 	if !input1.IsLegit() {
 		return input1, nil
@@ -214,10 +218,10 @@ func BIF_string_does_not_match_regexp(input1, input2 *mlrval.Mlrval) (retval *ml
 
 func BIF_regextract(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	if !input1.IsString() {
-		return mlrval.FromNotStringError("!=~", input1)
+		return mlrval.FromNotStringError("regextract", input1)
 	}
 	if !input2.IsString() {
-		return mlrval.FromNotStringError("!=~", input2)
+		return mlrval.FromNotStringError("regextract", input2)
 	}
 	regex := lib.CompileMillerRegexOrDie(input2.AcquireStringValue())
 	match := regex.FindStringIndex(input1.AcquireStringValue())
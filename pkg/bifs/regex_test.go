@@ -0,0 +1,80 @@
+package bifs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnkerl/miller/pkg/mlrval"
+)
+
+func TestBIF_string_matches_regexp(t *testing.T) {
+	cases := []struct {
+		input    *mlrval.Mlrval
+		regex    string
+		expected bool
+	}{
+		{mlrval.FromString("abc"), "^a.c$", true},
+		{mlrval.FromString("abc"), "^b", false},
+		{mlrval.FromString("abc"), "", true},
+		{mlrval.VOID, "^$", true},
+		{mlrval.FromString("ABC"), "b", false},
+		{mlrval.FromString("ABC"), `"b"i`, true},
+		{mlrval.FromString("ABC"), `"^abc$"i`, true},
+		{mlrval.FromString("ABD"), `"^abc$"i`, false},
+		// Numbers are matched using their original string representation
+		{mlrval.FromDeferredType("0xff"), "^0x", true},
+		{mlrval.FromDeferredType("1.500"), "00$", true},
+		{mlrval.FromInt(123), "^12", true},
+		{mlrval.FromBool(true), "^t", true},
+	}
+	for _, c := range cases {
+		output, _ := BIF_string_matches_regexp(c.input, mlrval.FromString(c.regex))
+		assert.True(t, output.IsBool(), "%s =~ %s", c.input.String(), c.regex)
+		assert.Equal(t, c.expected, output.AcquireBoolValue(), "%s =~ %s", c.input.String(), c.regex)
+
+		output, _ = BIF_string_does_not_match_regexp(c.input, mlrval.FromString(c.regex))
+		assert.True(t, output.IsBool(), "%s !=~ %s", c.input.String(), c.regex)
+		assert.Equal(t, !c.expected, output.AcquireBoolValue(), "%s !=~ %s", c.input.String(), c.regex)
+	}
+}
+
+func TestBIF_string_matches_regexp_captures(t *testing.T) {
+	output, captures := BIF_string_matches_regexp(
+		mlrval.FromString("index ab09 file"),
+		mlrval.FromString("([a-z][a-z])([0-9][0-9])"),
+	)
+	assert.True(t, output.AcquireBoolValue())
+	assert.Equal(t, "ab", captures[1])
+	assert.Equal(t, "09", captures[2])
+
+	// A non-match clears the captures, so "\1" etc. become empty
+	output, captures = BIF_string_matches_regexp(
+		mlrval.FromString("index file"),
+		mlrval.FromString("([a-z][a-z])([0-9][0-9])"),
+	)
+	assert.False(t, output.AcquireBoolValue())
+	assert.Equal(t, "", captures[1])
+	assert.Equal(t, "", captures[2])
+}
+
+func TestBIF_string_matches_regexp_non_strings(t *testing.T) {
+	abc := mlrval.FromString("abc")
+
+	output, _ := BIF_string_matches_regexp(mlrval.ABSENT, abc)
+	assert.True(t, output.IsAbsent())
+	output, _ = BIF_string_does_not_match_regexp(mlrval.ABSENT, abc)
+	assert.True(t, output.IsAbsent())
+
+	output, _ = BIF_string_matches_regexp(abc, mlrval.FromInt(3))
+	assert.True(t, output.IsError())
+	output, _ = BIF_string_does_not_match_regexp(abc, mlrval.FromInt(3))
+	assert.True(t, output.IsError())
+}
+
+func TestBIF_regextract(t *testing.T) {
+	assert.Equal(t, "ab09", BIF_regextract(mlrval.FromString("index ab09 file"), mlrval.FromString("[a-z]+[0-9]+")).String())
+	assert.True(t, BIF_regextract(mlrval.FromString("index file"), mlrval.FromString("[0-9]+")).IsAbsent())
+	assert.True(t, BIF_regextract(mlrval.FromInt(3), mlrval.FromString("[0-9]+")).IsError())
+	assert.Equal(t, "no", BIF_regextract_or_else(mlrval.FromString("abc"), mlrval.FromString("[0-9]+"), mlrval.FromString("no")).String())
+}
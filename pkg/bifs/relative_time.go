@@ -3,7 +3,8 @@ package bifs
 import (
 	"fmt"
 	"math"
-	"strings"
+	"regexp"
+	"strconv"
 
 	"github.com/johnkerl/miller/pkg/mlrval"
 )
@@ -132,6 +133,11 @@ func BIF_dhms2fsec(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromFloat(seconds)
 }
 
+// hmsRegex matches the whole of an hms2sec/hms2fsec input, such as
+// "01:23:20" or "-01:23:20.25". Sscanf alone would skip leading spaces and
+// accept trailing ones.
+var hmsRegex = regexp.MustCompile(`^(-?)([0-9]+):([0-9]+):([0-9]+(\.[0-9]*)?)$`)
+
 func BIF_hms2sec(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	if !input1.IsString() {
 		return mlrval.FromNotStringError("hms2sec", input1)
@@ -139,22 +145,23 @@ func BIF_hms2sec(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	if input1.AcquireStringValue() == "" {
 		return mlrval.FromNotStringError("hms2sec", input1)
 	}
-	var h, m, s int64
 
-	if strings.HasPrefix(input1.AcquireStringValue(), "-") {
-		n, err := fmt.Sscanf(input1.AcquireStringValue(), "-%d:%d:%d", &h, &m, &s)
-		if n == 3 && err == nil {
-			return mlrval.FromInt(-(s + m*60 + h*60*60))
-		}
-	} else {
-		n, err := fmt.Sscanf(input1.AcquireStringValue(), "%d:%d:%d", &h, &m, &s)
-		if n == 3 && err == nil {
-			return mlrval.FromInt(s + m*60 + h*60*60)
+	matches := hmsRegex.FindStringSubmatch(input1.AcquireStringValue())
+	if matches != nil && matches[5] == "" {
+		h, herr := strconv.ParseInt(matches[2], 10, 64)
+		m, merr := strconv.ParseInt(matches[3], 10, 64)
+		s, serr := strconv.ParseInt(matches[4], 10, 64)
+		if herr == nil && merr == nil && serr == nil {
+			if matches[1] == "-" {
+				return mlrval.FromInt(-(s + m*60 + h*60*60))
+			} else {
+				return mlrval.FromInt(s + m*60 + h*60*60)
+			}
 		}
 	}
 
 	return mlrval.FromError(
-		fmt.Errorf("hsm2sec: could not parse input \"%s\"", input1.OriginalString()),
+		fmt.Errorf("hms2sec: could not parse input \"%s\"", input1.OriginalString()),
 	)
 }
 
@@ -163,23 +170,22 @@ func BIF_hms2fsec(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 		return mlrval.FromNotStringError("hms2fsec", input1)
 	}
 
-	var h, m int
-	var s float64
-
-	if strings.HasPrefix(input1.AcquireStringValue(), "-") {
-		n, err := fmt.Sscanf(input1.AcquireStringValue(), "-%d:%d:%f", &h, &m, &s)
-		if n == 3 && err == nil {
-			return mlrval.FromFloat(-(s + float64(m*60+h*60*60)))
-		}
-	} else {
-		n, err := fmt.Sscanf(input1.AcquireStringValue(), "%d:%d:%f", &h, &m, &s)
-		if n == 3 && err == nil {
-			return mlrval.FromFloat(s + float64(m*60+h*60*60))
+	matches := hmsRegex.FindStringSubmatch(input1.AcquireStringValue())
+	if matches != nil {
+		h, herr := strconv.ParseInt(matches[2], 10, 64)
+		m, merr := strconv.ParseInt(matches[3], 10, 64)
+		s, serr := strconv.ParseFloat(matches[4], 64)
+		if herr == nil && merr == nil && serr == nil {
+			if matches[1] == "-" {
+				return mlrval.FromFloat(-(s + float64(m*60+h*60*60)))
+			} else {
+				return mlrval.FromFloat(s + float64(m*60+h*60*60))
+			}
 		}
 	}
 
 	return mlrval.FromError(
-		fmt.Errorf("hsm2fsec: could not parse input \"%s\"", input1.OriginalString()),
+		fmt.Errorf("hms2fsec: could not parse input \"%s\"", input1.OriginalString()),
 	)
 }
 
@@ -235,8 +241,12 @@ func BIF_sec2hms(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 func BIF_fsec2dhms(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	fsec, ok := input1.GetNumericToFloatValue()
 	if !ok {
-		return mlrval.FromNotIntError("fsec2dhms", input1)
+		return mlrval.FromNotNumericError("fsec2dhms", input1)
+	}
+	if math.IsNaN(fsec) || math.IsInf(fsec, 0) {
+		return input1
 	}
+	fsec = roundToMicroseconds(fsec)
 
 	sign := int64(1)
 	if fsec < 0 {
@@ -285,8 +295,12 @@ func BIF_fsec2dhms(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 func BIF_fsec2hms(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	fsec, ok := input1.GetNumericToFloatValue()
 	if !ok {
-		return mlrval.FromNotIntError("fsec2hms", input1)
+		return mlrval.FromNotNumericError("fsec2hms", input1)
 	}
+	if math.IsNaN(fsec) || math.IsInf(fsec, 0) {
+		return input1
+	}
+	fsec = roundToMicroseconds(fsec)
 
 	sign := ""
 	if fsec < 0 {
@@ -313,6 +327,14 @@ func BIF_fsec2hms(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	}
 }
 
+// roundToMicroseconds rounds to the resolution of the fsec2dhms/fsec2hms
+// output formats. Without this, 59.9999999 would have its integer part
+// truncated to 59 and its fractional part printed as 1.000000, giving
+// "60.000000s" rather than "1m00.000000s".
+func roundToMicroseconds(fsec float64) float64 {
+	return math.Round(fsec*1.0e6) / 1.0e6
+}
+
 // Helper function
 func splitIntToDHMS(u int64, pd, ph, pm, ps *int64) {
 	d := int64(0)
@@ -0,0 +1,150 @@
+package bifs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnkerl/miller/pkg/mlrval"
+)
+
+func TestBIF_sec2dhms(t *testing.T) {
+	cases := []struct {
+		input    int64
+		expected string
+	}{
+		{0, "0s"},
+		{59, "59s"},
+		{60, "1m00s"},
+		{3600, "1h00m00s"},
+		{86399, "23h59m59s"},
+		{86400, "1d00h00m00s"},
+		{94000, "1d02h06m40s"},
+		{-1, "-1s"},
+		{-60, "-1m00s"},
+		{-94000, "-1d02h06m40s"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, BIF_sec2dhms(mlrval.FromInt(c.input)).String(), "input=%d", c.input)
+	}
+	assert.True(t, BIF_sec2dhms(mlrval.FromString("abc")).IsError())
+	assert.True(t, BIF_sec2dhms(mlrval.FromFloat(1.5)).IsError())
+}
+
+func TestBIF_dhms2sec(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected int64
+	}{
+		{"0s", 0},
+		{"1d00h00m00s", 86400},
+		{"1d02h06m40s", 94000},
+		{"1d", 86400},
+		{"24h", 86400},
+		{"-1d02h06m40s", -94000},
+		{"-5s", -5},
+	}
+	for _, c := range cases {
+		output := BIF_dhms2sec(mlrval.FromString(c.input))
+		intval, ok := output.GetIntValue()
+		assert.True(t, ok, "input=%s", c.input)
+		assert.Equal(t, c.expected, intval, "input=%s", c.input)
+	}
+	assert.True(t, BIF_dhms2sec(mlrval.FromString("")).IsError())
+	assert.True(t, BIF_dhms2sec(mlrval.FromString("1x")).IsError())
+	assert.True(t, BIF_dhms2sec(mlrval.FromString("12")).IsError())
+}
+
+func TestBIF_sec2hms_hms2sec(t *testing.T) {
+	cases := []struct {
+		input    int64
+		expected string
+	}{
+		{0, "00:00:00"},
+		{1, "00:00:01"},
+		{5000, "01:23:20"},
+		{86400, "24:00:00"},
+		{94000, "26:06:40"},
+		{-5000, "-01:23:20"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, BIF_sec2hms(mlrval.FromInt(c.input)).String(), "input=%d", c.input)
+		intval, ok := BIF_hms2sec(mlrval.FromString(c.expected)).GetIntValue()
+		assert.True(t, ok, "input=%s", c.expected)
+		assert.Equal(t, c.input, intval, "input=%s", c.expected)
+	}
+
+	assert.True(t, BIF_hms2sec(mlrval.FromString("")).IsError())
+	assert.True(t, BIF_hms2sec(mlrval.FromString("1:2")).IsError())
+	assert.True(t, BIF_hms2sec(mlrval.FromString("1:2:3:4")).IsError())
+	assert.True(t, BIF_hms2sec(mlrval.FromString("1:2:3.5")).IsError())
+	assert.True(t, BIF_hms2sec(mlrval.FromString("1:2:3 ")).IsError())
+	assert.True(t, BIF_hms2sec(mlrval.FromString(" 1:2:3")).IsError())
+	assert.True(t, BIF_hms2sec(mlrval.FromString("1: 2:3")).IsError())
+	assert.True(t, BIF_hms2sec(mlrval.FromString("1:-2:3")).IsError())
+}
+
+func TestBIF_fsec2hms_hms2fsec(t *testing.T) {
+	cases := []struct {
+		input    float64
+		expected string
+	}{
+		{0.0, "00:00:00.000000"},
+		{1.25, "00:00:01.250000"},
+		{5000.25, "01:23:20.250000"},
+		{86400.0, "24:00:00.000000"},
+		{-0.25, "-00:00:00.250000"},
+		{-5000.25, "-01:23:20.250000"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, BIF_fsec2hms(mlrval.FromFloat(c.input)).String(), "input=%v", c.input)
+		floatval, ok := BIF_hms2fsec(mlrval.FromString(c.expected)).GetNumericToFloatValue()
+		assert.True(t, ok, "input=%s", c.expected)
+		assert.InDelta(t, c.input, floatval, 1e-9, "input=%s", c.expected)
+	}
+
+	// Rounding to microseconds carries into the minutes
+	assert.Equal(t, "00:01:00.000000", BIF_fsec2hms(mlrval.FromFloat(59.9999999)).String())
+	assert.Equal(t, "00:00:00.000000", BIF_fsec2hms(mlrval.FromFloat(-0.0000001)).String())
+
+	assert.True(t, BIF_fsec2hms(mlrval.FromString("abc")).IsError())
+	assert.True(t, BIF_hms2fsec(mlrval.FromString("01:02:03.25x")).IsError())
+	assert.True(t, BIF_hms2fsec(mlrval.FromString("01:02:03.25 ")).IsError())
+}
+
+func TestBIF_fsec2dhms_dhms2fsec(t *testing.T) {
+	cases := []struct {
+		input    float64
+		expected string
+	}{
+		{0.0, "0.000000s"},
+		{1.25, "1.250000s"},
+		{86400.0, "1d00h00m00.000000s"},
+		{94000.5, "1d02h06m40.500000s"},
+		{-0.25, "-0.250000s"},
+		{-94000.5, "-1d02h06m40.500000s"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, BIF_fsec2dhms(mlrval.FromFloat(c.input)).String(), "input=%v", c.input)
+		floatval, ok := BIF_dhms2fsec(mlrval.FromString(c.expected)).GetNumericToFloatValue()
+		assert.True(t, ok, "input=%s", c.expected)
+		assert.InDelta(t, c.input, floatval, 1e-9, "input=%s", c.expected)
+	}
+
+	assert.Equal(t, "1m00.000000s", BIF_fsec2dhms(mlrval.FromFloat(59.9999999)).String())
+	assert.Equal(t, "1d00h00m00.000000s", BIF_fsec2dhms(mlrval.FromFloat(86399.9999997)).String())
+}
+
+func TestDHMSRoundTrips(t *testing.T) {
+	for _, isec := range []int64{0, 1, 59, 60, 3599, 3600, 86399, 86400, 86401, 94000, 1000000} {
+		for _, sign := range []int64{1, -1} {
+			input := mlrval.FromInt(sign * isec)
+
+			intval, _ := BIF_dhms2sec(BIF_sec2dhms(input)).GetIntValue()
+			assert.Equal(t, sign*isec, intval, "dhms %d", sign*isec)
+
+			intval, _ = BIF_hms2sec(BIF_sec2hms(input)).GetIntValue()
+			assert.Equal(t, sign*isec, intval, "hms %d", sign*isec)
+		}
+	}
+}
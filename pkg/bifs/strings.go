@@ -12,11 +12,17 @@ import (
 )
 
 // ================================================================
+// BIF_strlen counts UTF-8 characters, not bytes. Numbers are measured by their
+// original string representation, so strlen of 0xff from data is 4.
 func BIF_strlen(input1 *mlrval.Mlrval) *mlrval.Mlrval {
-	if !input1.IsStringOrVoid() {
-		return mlrval.FromTypeErrorUnary("strlen", input1)
-	} else {
+	if input1.IsStringOrVoid() {
 		return mlrval.FromInt(lib.UTF8Strlen(input1.AcquireStringValue()))
+	} else if input1.IsNumeric() {
+		return mlrval.FromInt(lib.UTF8Strlen(input1.OriginalString()))
+	} else if input1.IsAbsent() {
+		return input1
+	} else {
+		return mlrval.FromNotStringError("strlen", input1)
 	}
 }
 
@@ -38,6 +44,10 @@ func BIF_string(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 // "102". Unlike with "+", with "." there is no ambiguity about what the output
 // should be: always the string concatenation of the string representations of
 // the two arguments. So, we do the string-cast for the user.
+//
+// Arrays and maps have no string representation for dotting, so dotting them
+// with anything non-empty is an error. Dotting them with void, null, or absent
+// is absent, the same whichever side the collection is on.
 
 func dot_s_xx(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 	return mlrval.FromString(input1.String() + input2.String())
@@ -54,10 +64,10 @@ var dot_dispositions = [mlrval.MT_DIM][mlrval.MT_DIM]BinaryFunc{
 	/*BOOL   */ {dot_s_xx, dot_s_xx, dot_s_xx, _s1__, dot_s_xx, dot_te, dot_te, dot_te, dot_te, _1___, _s1__},
 	/*VOID   */ {_s2__, _s2__, _s2__, _void, _2___, _absn, _absn, dot_te, dot_te, _void, _void},
 	/*STRING */ {dot_s_xx, dot_s_xx, dot_s_xx, _1___, dot_s_xx, dot_te, dot_te, dot_te, dot_te, _1___, _1___},
-	/*ARRAY  */ {dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te},
-	/*MAP    */ {dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te},
+	/*ARRAY  */ {dot_te, dot_te, dot_te, _absn, dot_te, dot_te, dot_te, dot_te, dot_te, _absn, _absn},
+	/*MAP    */ {dot_te, dot_te, dot_te, _absn, dot_te, dot_te, dot_te, dot_te, dot_te, _absn, _absn},
 	/*FUNC   */ {dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te},
-	/*ERROR  */ {dot_te, dot_te, dot_te, dot_te, dot_te, _absn, _absn, dot_te, dot_te, dot_te, dot_te},
+	/*ERROR  */ {dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te, dot_te},
 	/*NULL   */ {_s2__, _s2__, _s2__, _void, _2___, _absn, _absn, dot_te, dot_te, _null, _null},
 	/*ABSENT */ {_s2__, _s2__, _s2__, _void, _2___, _absn, _absn, dot_te, dot_te, _null, _absn},
 }
@@ -68,13 +78,15 @@ func BIF_dot(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 
 // ================================================================
 // substr1(s,m,n) gives substring of s from 1-up position m to n inclusive.
-// Negative indices -len .. -1 alias to 0 .. len-1.
+// Negative indices -len .. -1 alias to 1 .. len. Indexing is by UTF-8
+// character, not byte. Out-of-bounds indices are clamped, as with array
+// slices, and m > n gives the empty string.
 
 func BIF_substr_1_up(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
 	if input1.IsAbsent() {
 		return mlrval.ABSENT
 	}
-	if input1.IsError() {
+	if input1.IsError() || input1.IsArrayOrMap() {
 		return mlrval.FromTypeErrorUnary("substr1", input1)
 	}
 	sinput := input1.String()
@@ -106,7 +118,7 @@ func BIF_substr_0_up(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
 	if input1.IsAbsent() {
 		return mlrval.ABSENT
 	}
-	if input1.IsError() {
+	if input1.IsError() || input1.IsArrayOrMap() {
 		return mlrval.FromTypeErrorUnary("substr0", input1)
 	}
 	sinput := input1.String()
@@ -305,23 +317,29 @@ func BIF_collapse_whitespace_regexp(input1 *mlrval.Mlrval, whitespaceRegexp *reg
 var _whitespace_regexp = regexp.MustCompile(`\s+`)
 
 // ================================================================
+// Case-changers pass numbers, empty, and absent through unmodified. Other
+// non-string types, such as booleans and maps, are an error.
+func case_changer_passes_through(input1 *mlrval.Mlrval) bool {
+	return input1.IsNumeric() || input1.IsVoid() || input1.IsAbsent()
+}
+
 func BIF_toupper(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	if input1.IsString() {
 		return mlrval.FromString(strings.ToUpper(input1.AcquireStringValue()))
-	} else if input1.IsVoid() {
+	} else if case_changer_passes_through(input1) {
 		return input1
 	} else {
-		return input1
+		return mlrval.FromNotStringError("toupper", input1)
 	}
 }
 
 func BIF_tolower(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	if input1.IsString() {
 		return mlrval.FromString(strings.ToLower(input1.AcquireStringValue()))
-	} else if input1.IsVoid() {
+	} else if case_changer_passes_through(input1) {
 		return input1
 	} else {
-		return input1
+		return mlrval.FromNotStringError("tolower", input1)
 	}
 }
 
@@ -337,13 +355,18 @@ func BIF_capitalize(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 			srest := string(rrest)
 			return mlrval.FromString(sfirst + srest)
 		}
-	} else {
+	} else if case_changer_passes_through(input1) {
 		return input1
+	} else {
+		return mlrval.FromNotStringError("capitalize", input1)
 	}
 }
 
 // ----------------------------------------------------------------
 func BIF_clean_whitespace(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	if !input1.IsString() {
+		return input1
+	}
 	mv := BIF_strip(
 		BIF_collapse_whitespace_regexp(
 			input1, _whitespace_regexp,
@@ -477,11 +500,16 @@ func bif_unformat_aux(input1, input2 *mlrval.Mlrval, inferTypes bool) *mlrval.Ml
 }
 
 // ================================================================
+// BIF_hexfmt formats ints as hex, with negative ints shown in two's
+// complement. Absent, empty, and error inputs are passed through; anything
+// else, such as a float or non-empty string, is an error.
 func BIF_hexfmt(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	if input1.IsInt() {
 		return mlrval.FromString("0x" + strconv.FormatUint(uint64(input1.AcquireIntValue()), 16))
-	} else {
+	} else if input1.IsAbsent() || input1.IsVoid() || input1.IsError() {
 		return input1
+	} else {
+		return mlrval.FromNotIntError("hexfmt", input1)
 	}
 }
 
@@ -600,3 +628,18 @@ func BIF_utf8_to_latin1(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 		return input1
 	}
 }
+
+// UTF8ToLatin1Replacing is like BIF_utf8_to_latin1 except that characters not
+// encodable as Latin-1 are replaced rather than making the value an error.
+// This is for the utf8-to-latin1 verb.
+func UTF8ToLatin1Replacing(input1 *mlrval.Mlrval, replacement string) *mlrval.Mlrval {
+	if input1.IsArray() || input1.IsMap() {
+		return recurseUnaryFuncOnInput1(func(element *mlrval.Mlrval) *mlrval.Mlrval {
+			return UTF8ToLatin1Replacing(element, replacement)
+		}, input1)
+	} else if input1.IsString() {
+		return mlrval.FromString(lib.UTF8ToLatin1WithReplacement(input1.String(), replacement))
+	} else {
+		return input1
+	}
+}
@@ -0,0 +1,185 @@
+package bifs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnkerl/miller/pkg/mlrval"
+)
+
+func TestBIF_dot_scalars(t *testing.T) {
+	output := BIF_dot(mlrval.FromString("abc"), mlrval.FromInt(10))
+	assert.Equal(t, "abc10", output.String())
+
+	output = BIF_dot(mlrval.FromInt(1), mlrval.FromInt(2))
+	assert.Equal(t, "12", output.String())
+
+	output = BIF_dot(mlrval.VOID, mlrval.FromString("abc"))
+	assert.Equal(t, "abc", output.String())
+
+	output = BIF_dot(mlrval.FromString("abc"), mlrval.ABSENT)
+	assert.Equal(t, "abc", output.String())
+}
+
+func TestBIF_dot_collections(t *testing.T) {
+	mapval := mlrval.NewMlrmap()
+	mapval.PutReference("key", mlrval.FromString("value"))
+	collections := []*mlrval.Mlrval{
+		mlrval.FromMap(mapval),
+		mlrval.FromArray([]*mlrval.Mlrval{mlrval.FromInt(1)}),
+	}
+
+	for _, collection := range collections {
+		assert.True(t, BIF_dot(collection, mlrval.FromString("abc")).IsError())
+		assert.True(t, BIF_dot(mlrval.FromString("abc"), collection).IsError())
+		assert.True(t, BIF_dot(collection, mlrval.FromInt(1)).IsError())
+		assert.True(t, BIF_dot(collection, collection).IsError())
+		assert.True(t, BIF_dot(mlrval.FromErrorString("error"), collection).IsError())
+
+		assert.True(t, BIF_dot(collection, mlrval.VOID).IsAbsent())
+		assert.True(t, BIF_dot(mlrval.VOID, collection).IsAbsent())
+		assert.True(t, BIF_dot(collection, mlrval.ABSENT).IsAbsent())
+		assert.True(t, BIF_dot(mlrval.ABSENT, collection).IsAbsent())
+	}
+}
+
+func TestBIF_hexfmt(t *testing.T) {
+	assert.Equal(t, "0xff", BIF_hexfmt(mlrval.FromInt(255)).String())
+	assert.Equal(t, "0xffffffffffffffff", BIF_hexfmt(mlrval.FromInt(-1)).String())
+	assert.Equal(t, "0x1f", BIF_hexfmt(mlrval.FromDeferredType("0x1f")).String())
+	assert.Equal(t, "0xa", BIF_hexfmt(mlrval.FromDeferredType("0b1010")).String())
+
+	assert.True(t, BIF_hexfmt(mlrval.FromFloat(3.5)).IsError())
+	assert.True(t, BIF_hexfmt(mlrval.FromString("abc")).IsError())
+	assert.True(t, BIF_hexfmt(mlrval.FromBool(true)).IsError())
+
+	assert.True(t, BIF_hexfmt(mlrval.ABSENT).IsAbsent())
+	assert.True(t, BIF_hexfmt(mlrval.VOID).IsVoid())
+}
+
+func TestBIF_hexfmt_round_trip(t *testing.T) {
+	for _, input := range []int64{0, 1, 31, 255, 0x7fffffffffffffff, -1, -255} {
+		hex := BIF_hexfmt(mlrval.FromInt(input)).String()
+		output, ok := mlrval.FromDeferredType(hex).GetIntValue()
+		assert.True(t, ok, hex)
+		assert.Equal(t, input, output, hex)
+	}
+}
+
+func TestBIF_strlen(t *testing.T) {
+	cases := []struct {
+		input    *mlrval.Mlrval
+		expected int64
+	}{
+		{mlrval.FromString("abc"), 3},
+		{mlrval.VOID, 0},
+		{mlrval.FromString("héllo"), 5},
+		{mlrval.FromString("çà et là"), 8},
+		{mlrval.FromString("日本語"), 3},
+		{mlrval.FromString("中文 text"), 7},
+		{mlrval.FromDeferredType("12345"), 5},
+		{mlrval.FromDeferredType("0xff"), 4},
+		{mlrval.FromDeferredType("1.500"), 5},
+		{mlrval.FromInt(-17), 3},
+	}
+	for _, c := range cases {
+		intval, ok := BIF_strlen(c.input).GetIntValue()
+		assert.True(t, ok, c.input.String())
+		assert.Equal(t, c.expected, intval, c.input.String())
+	}
+
+	assert.True(t, BIF_strlen(mlrval.ABSENT).IsAbsent())
+	assert.True(t, BIF_strlen(mlrval.FromBool(true)).IsError())
+	assert.True(t, BIF_strlen(mlrval.FromEmptyMap()).IsError())
+}
+
+func TestBIF_case_changers(t *testing.T) {
+	assert.Equal(t, "HÉLLO WORLD", BIF_toupper(mlrval.FromString("héllo world")).String())
+	assert.Equal(t, "héllo world", BIF_tolower(mlrval.FromString("HÉLLO World")).String())
+	assert.Equal(t, "Élan vital", BIF_capitalize(mlrval.FromString("élan vital")).String())
+	assert.Equal(t, "ÇA", BIF_toupper(mlrval.FromString("ça")).String())
+
+	// CJK has no case
+	assert.Equal(t, "日本語", BIF_toupper(mlrval.FromString("日本語")).String())
+	assert.Equal(t, "日本語", BIF_capitalize(mlrval.FromString("日本語")).String())
+
+	funcs := map[string]UnaryFunc{
+		"toupper":    BIF_toupper,
+		"tolower":    BIF_tolower,
+		"capitalize": BIF_capitalize,
+	}
+	for name, f := range funcs {
+		assert.Equal(t, "0xff", f(mlrval.FromDeferredType("0xff")).String(), name)
+		assert.True(t, f(mlrval.FromDeferredType("0xff")).IsInt(), name)
+		assert.True(t, f(mlrval.VOID).IsVoid(), name)
+		assert.True(t, f(mlrval.ABSENT).IsAbsent(), name)
+		assert.True(t, f(mlrval.FromBool(true)).IsError(), name)
+		assert.True(t, f(mlrval.FromEmptyMap()).IsError(), name)
+	}
+}
+
+func TestBIF_clean_whitespace(t *testing.T) {
+	assert.Equal(t, "a b c", BIF_clean_whitespace(mlrval.FromString("  a   b \t c  ")).String())
+	assert.Equal(t, "日本 語", BIF_clean_whitespace(mlrval.FromString(" 日本  語 ")).String())
+	assert.Equal(t, "", BIF_clean_whitespace(mlrval.FromString(" \t ")).String())
+
+	// The result is type-inferred
+	output := BIF_clean_whitespace(mlrval.FromString(" 12 "))
+	assert.True(t, output.IsInt())
+	assert.Equal(t, "12", output.String())
+
+	assert.True(t, BIF_clean_whitespace(mlrval.ABSENT).IsAbsent())
+	assert.True(t, BIF_clean_whitespace(mlrval.VOID).IsVoid())
+	assert.True(t, BIF_clean_whitespace(mlrval.FromInt(3)).IsInt())
+}
+
+func TestBIF_substr_1_up(t *testing.T) {
+	cases := []struct {
+		input    string
+		lo       int64
+		hi       int64
+		expected string
+	}{
+		{"héllo", 1, 2, "hé"},
+		{"héllo", 2, 2, "é"},
+		{"héllo", 1, 5, "héllo"},
+		{"日本語", 2, 3, "本語"},
+		// Negative indices count from the end
+		{"héllo", -3, -1, "llo"},
+		{"héllo", -5, 2, "hé"},
+		{"日本語", -2, -2, "本"},
+		// Out-of-bounds indices clamp
+		{"héllo", 0, 2, "hé"},
+		{"héllo", 4, 10, "lo"},
+		{"héllo", -10, 2, "hé"},
+		{"héllo", -10, 10, "héllo"},
+		// Empty results
+		{"héllo", 3, 2, ""},
+		{"héllo", 6, 10, ""},
+		{"héllo", -10, -8, ""},
+		{"", 1, 1, ""},
+	}
+	for _, c := range cases {
+		output := BIF_substr_1_up(mlrval.FromString(c.input), mlrval.FromInt(c.lo), mlrval.FromInt(c.hi))
+		assert.Equal(t, c.expected, output.String(), "substr1(%s,%d,%d)", c.input, c.lo, c.hi)
+	}
+
+	// Non-string inputs are stringified
+	output := BIF_substr_1_up(mlrval.FromInt(12345), mlrval.FromInt(2), mlrval.FromInt(3))
+	assert.Equal(t, "23", output.String())
+
+	one := mlrval.FromInt(1)
+	assert.True(t, BIF_substr_1_up(mlrval.ABSENT, one, one).IsAbsent())
+	assert.True(t, BIF_substr_1_up(mlrval.FromString("abc"), mlrval.ABSENT, one).IsAbsent())
+	assert.True(t, BIF_substr_1_up(mlrval.FromString("abc"), mlrval.FromString("x"), one).IsError())
+	assert.True(t, BIF_substr_1_up(mlrval.FromEmptyMap(), one, one).IsError())
+}
+
+func TestBIF_substr_0_up(t *testing.T) {
+	hello := mlrval.FromString("héllo")
+	assert.Equal(t, "hé", BIF_substr_0_up(hello, mlrval.FromInt(0), mlrval.FromInt(1)).String())
+	assert.Equal(t, "llo", BIF_substr_0_up(hello, mlrval.FromInt(-3), mlrval.FromInt(-1)).String())
+	assert.Equal(t, "", BIF_substr_0_up(hello, mlrval.FromInt(3), mlrval.FromInt(2)).String())
+	assert.True(t, BIF_substr_0_up(mlrval.FromArray([]*mlrval.Mlrval{}), mlrval.FromInt(0), mlrval.FromInt(1)).IsError())
+}
@@ -32,8 +32,22 @@ func string_to_int(input1 *mlrval.Mlrval) *mlrval.Mlrval {
 	}
 }
 
+// float_to_int truncates toward zero. NaN, and floats outside the 64-bit int
+// range, have no int value: Go's conversion of them is implementation-specific,
+// so we make them an error.
 func float_to_int(input1 *mlrval.Mlrval) *mlrval.Mlrval {
-	return mlrval.FromInt(int64(input1.AcquireFloatValue()))
+	f := input1.AcquireFloatValue()
+	if math.IsNaN(f) || f < -(1<<63) || f >= 1<<63 {
+		return mlrval.FromError(
+			fmt.Errorf(
+				"%s: unacceptable value %s with type %s",
+				"int",
+				input1.StringMaybeQuoted(),
+				input1.GetTypeName(),
+			),
+		)
+	}
+	return mlrval.FromInt(int64(f))
 }
 
 func bool_to_int(input1 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -88,7 +102,7 @@ func int_to_int_with_base(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
 }
 
 func float_to_int_with_base(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
-	return mlrval.FromInt(int64(input1.AcquireFloatValue()))
+	return float_to_int(input1)
 }
 
 func bool_to_int_with_base(input1, input2 *mlrval.Mlrval) *mlrval.Mlrval {
@@ -0,0 +1,180 @@
+package bifs
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnkerl/miller/pkg/mlrval"
+)
+
+func TestTypePredicates(t *testing.T) {
+	predicates := map[string]UnaryFunc{
+		"is_present":   BIF_is_present,
+		"is_absent":    BIF_is_absent,
+		"is_empty":     BIF_is_empty,
+		"is_not_empty": BIF_is_notempty,
+		"is_string":    BIF_is_string,
+		"is_int":       BIF_is_int,
+		"is_float":     BIF_is_float,
+		"is_numeric":   BIF_is_numeric,
+		"is_boolean":   BIF_is_boolean,
+		"is_map":       BIF_is_map,
+		"is_not_map":   BIF_is_notmap,
+		"is_array":     BIF_is_array,
+		"is_not_array": BIF_is_notarray,
+		"is_null":      BIF_is_null,
+		"is_not_null":  BIF_is_notnull,
+		"is_error":     BIF_is_error,
+	}
+
+	// For each input, the predicates which should be true. All others should
+	// be false.
+	cases := []struct {
+		name  string
+		input *mlrval.Mlrval
+		trues []string
+	}{
+		{
+			"int", mlrval.FromInt(1),
+			[]string{"is_present", "is_not_empty", "is_int", "is_numeric", "is_not_map", "is_not_array", "is_not_null"},
+		},
+		{
+			"float", mlrval.FromFloat(2.5),
+			[]string{"is_present", "is_not_empty", "is_float", "is_numeric", "is_not_map", "is_not_array", "is_not_null"},
+		},
+		{
+			"int from data", mlrval.FromDeferredType("0xff"),
+			[]string{"is_present", "is_not_empty", "is_int", "is_numeric", "is_not_map", "is_not_array", "is_not_null"},
+		},
+		{
+			"float from data", mlrval.FromDeferredType("1e5"),
+			[]string{"is_present", "is_not_empty", "is_float", "is_numeric", "is_not_map", "is_not_array", "is_not_null"},
+		},
+		{
+			"string", mlrval.FromString("abc"),
+			[]string{"is_present", "is_not_empty", "is_string", "is_not_map", "is_not_array", "is_not_null"},
+		},
+		{
+			"string from data", mlrval.FromDeferredType("abc"),
+			[]string{"is_present", "is_not_empty", "is_string", "is_not_map", "is_not_array", "is_not_null"},
+		},
+		{
+			"boolean", mlrval.FromBool(true),
+			[]string{"is_present", "is_not_empty", "is_boolean", "is_not_map", "is_not_array", "is_not_null"},
+		},
+		{
+			// Booleans aren't inferred from data
+			"boolean from data", mlrval.FromDeferredType("true"),
+			[]string{"is_present", "is_not_empty", "is_string", "is_not_map", "is_not_array", "is_not_null"},
+		},
+		{
+			"empty", mlrval.VOID,
+			[]string{"is_present", "is_empty", "is_string", "is_not_map", "is_not_array", "is_null"},
+		},
+		{
+			"empty from data", mlrval.FromDeferredType(""),
+			[]string{"is_present", "is_empty", "is_string", "is_not_map", "is_not_array", "is_null"},
+		},
+		{
+			"map", mlrval.FromEmptyMap(),
+			[]string{"is_present", "is_not_empty", "is_map", "is_not_array", "is_not_null"},
+		},
+		{
+			"array", mlrval.FromArray([]*mlrval.Mlrval{mlrval.FromInt(1)}),
+			[]string{"is_present", "is_not_empty", "is_not_map", "is_array", "is_not_null"},
+		},
+		{
+			"error", mlrval.FromAnonymousError(),
+			[]string{"is_present", "is_not_empty", "is_not_map", "is_not_array", "is_not_null", "is_error"},
+		},
+		{
+			"absent", mlrval.ABSENT,
+			[]string{"is_absent", "is_not_map", "is_not_array", "is_null"},
+		},
+	}
+
+	for _, c := range cases {
+		trues := make(map[string]bool)
+		for _, name := range c.trues {
+			trues[name] = true
+		}
+		for name, predicate := range predicates {
+			output := predicate(c.input)
+			assert.True(t, output.IsBool(), "%s(%s)", name, c.name)
+			boolval, _ := output.GetBoolValue()
+			assert.Equal(t, trues[name], boolval, "%s(%s)", name, c.name)
+		}
+	}
+}
+
+func TestCasts(t *testing.T) {
+	cases := []struct {
+		name         string
+		output       *mlrval.Mlrval
+		expectedType string
+		expected     string
+	}{
+		{`int("10")`, BIF_int(mlrval.FromString("10")), "int", "10"},
+		{`int("0xff")`, BIF_int(mlrval.FromString("0xff")), "int", "255"},
+		{`int(3.9)`, BIF_int(mlrval.FromFloat(3.9)), "int", "3"},
+		{`int(-3.9)`, BIF_int(mlrval.FromFloat(-3.9)), "int", "-3"},
+		{`int(true)`, BIF_int(mlrval.FromBool(true)), "int", "1"},
+		{`int("10")+2`, BIF_plus_binary(BIF_int(mlrval.FromString("10")), mlrval.FromInt(2)), "int", "12"},
+		{`int("ff", 16)`, BIF_int_with_base(mlrval.FromString("ff"), mlrval.FromInt(16)), "int", "255"},
+		{`int(3.9, 16)`, BIF_int_with_base(mlrval.FromFloat(3.9), mlrval.FromInt(16)), "int", "3"},
+
+		{`float("1e3")`, BIF_float(mlrval.FromString("1e3")), "float", "1000"},
+		{`float(5)`, BIF_float(mlrval.FromInt(5)), "float", "5"},
+		{`float(false)`, BIF_float(mlrval.FromBool(false)), "float", "0"},
+
+		{`string(5)`, BIF_string(mlrval.FromInt(5)), "string", "5"},
+		{`string(true)`, BIF_string(mlrval.FromBool(true)), "string", "true"},
+		{`string(0xff)`, BIF_string(mlrval.FromDeferredType("0xff")), "string", "0xff"},
+
+		{`boolean("true")`, BIF_boolean(mlrval.FromString("true")), "bool", "true"},
+		{`boolean("false")`, BIF_boolean(mlrval.FromString("false")), "bool", "false"},
+		{`boolean(2)`, BIF_boolean(mlrval.FromInt(2)), "bool", "true"},
+		{`boolean(0.0)`, BIF_boolean(mlrval.FromFloat(0.0)), "bool", "false"},
+
+		// Empty and absent pass through
+		{`int("")`, BIF_int(mlrval.VOID), "empty", ""},
+		{`float(absent)`, BIF_float(mlrval.ABSENT), "absent", ""},
+		{`boolean("")`, BIF_boolean(mlrval.VOID), "empty", ""},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expectedType, c.output.GetTypeName(), c.name)
+		if c.expectedType != "absent" {
+			assert.Equal(t, c.expected, c.output.String(), c.name)
+		}
+	}
+}
+
+func TestCastFailures(t *testing.T) {
+	cases := []struct {
+		name   string
+		output *mlrval.Mlrval
+	}{
+		{`int("abc")`, BIF_int(mlrval.FromString("abc"))},
+		{`int("1.5")`, BIF_int(mlrval.FromString("1.5"))},
+		{`int(NaN)`, BIF_int(mlrval.FromFloat(math.NaN()))},
+		{`int(+Inf)`, BIF_int(mlrval.FromFloat(math.Inf(1)))},
+		{`int(1e30)`, BIF_int(mlrval.FromFloat(1e30))},
+		{`int(-1e30)`, BIF_int(mlrval.FromFloat(-1e30))},
+		{`int(1e30, 10)`, BIF_int_with_base(mlrval.FromFloat(1e30), mlrval.FromInt(10))},
+		{`int("12", "x")`, BIF_int_with_base(mlrval.FromString("12"), mlrval.FromString("x"))},
+		{`int({})`, BIF_int(mlrval.FromEmptyMap())},
+		{`float("abc")`, BIF_float(mlrval.FromString("abc"))},
+		{`float([])`, BIF_float(mlrval.FromArray([]*mlrval.Mlrval{}))},
+		{`boolean("yes")`, BIF_boolean(mlrval.FromString("yes"))},
+		{`boolean({})`, BIF_boolean(mlrval.FromEmptyMap())},
+	}
+	for _, c := range cases {
+		assert.True(t, c.output.IsError(), c.name)
+	}
+
+	// The int range is half-open
+	assert.Equal(t, "-9223372036854775808", BIF_int(mlrval.FromFloat(-9223372036854775808.0)).String())
+	assert.True(t, BIF_int(mlrval.FromFloat(9223372036854775808.0)).IsError())
+}
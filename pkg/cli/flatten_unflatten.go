@@ -39,7 +39,7 @@ package cli
 //   o On record-write, nested structures will be converted to string (carriage
 //     returns and all) using json_stringify. People *might* want this but
 //     (using POLS) we will (by default) AUTO-FLATTEN for them. There is a
-//     --no-auto-unflatten CLI flag for those who want it.
+//     --no-auto-flatten CLI flag for those who want it.
 //
 // * If input is non-JSON and output is non-JSON:
 //   o If there is a "req.method" field, people should be able to do
@@ -16,6 +16,7 @@ import (
 
 	"github.com/mattn/go-isatty"
 
+	"github.com/johnkerl/miller/pkg/bifs"
 	"github.com/johnkerl/miller/pkg/colorizer"
 	"github.com/johnkerl/miller/pkg/lib"
 	"github.com/johnkerl/miller/pkg/mlrval"
@@ -35,6 +36,29 @@ func FinalizeReaderOptions(readerOptions *TReaderOptions) error {
 	readerOptions.IFS = lib.UnhexStringLiteral(readerOptions.IFS)
 	readerOptions.IPS = lib.UnhexStringLiteral(readerOptions.IPS)
 
+	// The defaults are already unbackslashed, so it doesn't matter that they're
+	// filled in afterward.
+	readerOptions.IFS = lib.UnbackslashStringLiteral(readerOptions.IFS)
+	readerOptions.IPS = lib.UnbackslashStringLiteral(readerOptions.IPS)
+	readerOptions.IRS = lib.UnbackslashStringLiteral(readerOptions.IRS)
+
+	// With --auto-format, the defaults are filled in per input file once its
+	// format is known: see ReaderOptionsForDetectedFormat.
+	if readerOptions.InputFileFormat == AUTO_INPUT_FORMAT {
+		return nil
+	}
+
+	applyReaderOptionDefaults(readerOptions)
+
+	if readerOptions.IRS == "" {
+		return errors.New("empty IRS")
+	}
+	return nil
+}
+
+// applyReaderOptionDefaults fills in separators not specified on the command
+// line with the defaults for the input format.
+func applyReaderOptionDefaults(readerOptions *TReaderOptions) {
 	if !readerOptions.ifsWasSpecified {
 		readerOptions.IFS = defaultFSes[readerOptions.InputFileFormat]
 	}
@@ -50,19 +74,21 @@ func FinalizeReaderOptions(readerOptions *TReaderOptions) error {
 		// and if IFS wasn't specified.
 		if readerOptions.InputFileFormat == "nidx" && !readerOptions.ifsWasSpecified {
 			readerOptions.IFSRegex = lib.CompileMillerRegexOrDie(WHITESPACE_REGEX)
+			readerOptions.AllowRepeatIFS = true
 		} else {
 			readerOptions.AllowRepeatIFS = defaultAllowRepeatIFSes[readerOptions.InputFileFormat]
 		}
 	}
+}
 
-	readerOptions.IFS = lib.UnbackslashStringLiteral(readerOptions.IFS)
-	readerOptions.IPS = lib.UnbackslashStringLiteral(readerOptions.IPS)
-	readerOptions.IRS = lib.UnbackslashStringLiteral(readerOptions.IRS)
-
-	if readerOptions.IRS == "" {
-		return errors.New("empty IRS")
-	}
-	return nil
+// ReaderOptionsForDetectedFormat is for --auto-format. It returns a copy of
+// the reader options for the given input format, with defaults filled in for
+// separators which weren't specified on the command line.
+func ReaderOptionsForDetectedFormat(readerOptions *TReaderOptions, format string) *TReaderOptions {
+	formatOptions := *readerOptions
+	formatOptions.InputFileFormat = format
+	applyReaderOptionDefaults(&formatOptions)
+	return &formatOptions
 }
 
 // FinalizeWriterOptions unbackslashes OPS, OFS, and ORS.  This is because
@@ -520,6 +546,24 @@ var PPRINTOnlyFlagSection = FlagSection{
 				*pargi += 1
 			},
 		},
+
+		{
+			name: "--implicit-pprint-header",
+			help: "Use 1,2,3,... as field labels, rather than from line 1 of PPRINT input. This is the same as `--implicit-csv-header`.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.UseImplicitHeader = true
+				*pargi += 1
+			},
+		},
+
+		{
+			name: "--headerless-pprint-output",
+			help: "Print only PPRINT data lines; do not print PPRINT header lines. This is the same as `--headerless-csv-output`.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.WriterOptions.HeaderlessOutput = true
+				*pargi += 1
+			},
+		},
 	},
 }
 
@@ -651,6 +695,19 @@ var FileFormatFlagSection = FlagSection{
 			},
 		},
 
+		{
+			name: "--auto-format",
+			help: "Detect the input format of each file from its first line which isn't empty or a comment:\n" +
+				"JSON if it starts with `{` or `[`; DKVP if its comma-separated fields are all key=value\n" +
+				"pairs; CSV if it has at least two comma-separated fields, all non-empty and without `=`;\n" +
+				"else DKVP. A format flag after this one, such as `--icsv`, takes precedence as with any\n" +
+				"other pair of format flags. This is the same as `-i auto`.",
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				options.ReaderOptions.InputFileFormat = AUTO_INPUT_FORMAT
+				*pargi += 1
+			},
+		},
+
 		{
 			name: "--icsvlite",
 			help: "Use CSV-lite format for input data.",
@@ -2451,7 +2508,7 @@ var CSVTSVOnlyFlagSection = FlagSection{
 
 		{
 			name: "--quote-all",
-			help: "Force double-quoting of CSV fields.",
+			help: "Force double-quoting of CSV fields, including header fields. Without this flag, fields are double-quoted only when they contain the field separator, a double quote, or a line ending. Either way, embedded double quotes are doubled.",
 			parser: func(args []string, argc int, pargi *int, options *TOptions) {
 				options.WriterOptions.CSVQuoteAll = true
 				*pargi += 1
@@ -2901,7 +2958,7 @@ var FlattenUnflattenFlagSection = FlagSection{
 
 		{
 			name: "--no-auto-flatten",
-			help: "When output is non-JSON, suppress the default auto-flatten behavior. Default: if `$y = [7,8,9]` then this flattens to `y.1=7,y.2=8,y.3=9, and similarly for maps. With `--no-auto-flatten`, instead we get `$y=[1, 2, 3]`.",
+			help: "When output is non-JSON, suppress the default auto-flatten behavior. Default: if `$y = [7,8,9]` then this flattens to `y.1=7,y.2=8,y.3=9`, and similarly for maps. With `--no-auto-flatten`, instead we get `y=[7, 8, 9]`.",
 			parser: func(args []string, argc int, pargi *int, options *TOptions) {
 				options.WriterOptions.AutoFlatten = false
 				*pargi += 1
@@ -2910,7 +2967,7 @@ var FlattenUnflattenFlagSection = FlagSection{
 
 		{
 			name: "--no-auto-unflatten",
-			help: "When input non-JSON and output is JSON, suppress the default auto-unflatten behavior. Default: if the input has `y.1=7,y.2=8,y.3=9` then this unflattens to `$y=[7,8,9]`.  flattens to `y.1=7,y.2=8,y.3=9. With `--no-auto-flatten`, instead we get `${y.1}=7,${y.2}=8,${y.3}=9`.",
+			help: "When input is non-JSON and output is JSON, suppress the default auto-unflatten behavior. Default: if the input has `y.1=7,y.2=8,y.3=9` then this unflattens to `$y=[7,8,9]`. With `--no-auto-unflatten`, instead we get `${y.1}=7,${y.2}=8,${y.3}=9`.",
 			parser: func(args []string, argc int, pargi *int, options *TOptions) {
 				options.WriterOptions.AutoUnflatten = false
 				*pargi += 1
@@ -3186,7 +3243,7 @@ var MiscFlagSection = FlagSection{
 		{
 			name: "--seed",
 			arg:  "{n}",
-			help: "with `n` of the form `12345678` or `0xcafefeed`. For `put`/`filter` `urand`, `urandint`, and `urand32`.",
+			help: "with `n` of the form `12345678` or `0xcafefeed`. Seeds the random-number generator shared by the `bootstrap`, `sample`, and `shuffle` verbs and the `put`/`filter` functions `urand`, `urandint`, `urand32`, `urandrange`, and `urandelement`, so that their output is the same on each run.",
 
 			parser: func(args []string, argc int, pargi *int, options *TOptions) {
 				CheckArgCount(args, *pargi, argc, 2)
@@ -3283,13 +3340,27 @@ data having many fields, and many of them being processed during a given process
 			name:     "--infer-octal",
 			altNames: []string{"-O"},
 			help: `Treat numbers like 0123 in data files as numeric; default is string.
-Note that 00--07 etc scan as int; 08-09 scan as float.`,
+Such numbers scan as octal where they can, e.g. 0377 is 255, and as
+decimal otherwise, e.g. 0789 is 789.`,
 			parser: func(args []string, argc int, pargi *int, options *TOptions) {
 				mlrval.SetInferrerOctalAsInt()
 				*pargi += 1
 			},
 		},
 
+		{
+			name: "--lexical-mixed",
+			help: `In DSL ordering comparisons such as ` + "`<`" + ` and ` + "`<=>`" + ` between a number and a
+string, compare the number as a string, as earlier Miller versions did. By default numbers are less than
+strings, including empty strings, which is the same ordering as the sort verb and the sort, min, and max
+DSL functions use. For example, ` + "`10 < \"9\"`" + ` is true either way, but ` + "`10 < \"\"`" + ` is true
+by default and false with this flag.`,
+			parser: func(args []string, argc int, pargi *int, options *TOptions) {
+				bifs.SetLexicalMixedComparisons(true)
+				*pargi += 1
+			},
+		},
+
 		{
 			name: "--fflush",
 			help: `Force buffered output to be written after every output record.
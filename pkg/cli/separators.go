@@ -21,13 +21,13 @@ const TABS_REGEX = "(\\t)+"
 const WHITESPACE_REGEX = "([ \\t])+"
 
 const ASCII_ESC = "\\x1b"
-const ASCII_ETX = "\\x04"
+const ASCII_ETX = "\\x03"
 const ASCII_FS = "\\x1c"
 const ASCII_GS = "\\x1d"
-const ASCII_NULL = "\\x01"
+const ASCII_NULL = "\\x00"
 const ASCII_RS = "\\x1e"
-const ASCII_SOH = "\\x02"
-const ASCII_STX = "\\x03"
+const ASCII_SOH = "\\x01"
+const ASCII_STX = "\\x02"
 const ASCII_US = "\\x1f"
 
 const ASV_FS = "\\x1f"
@@ -79,6 +79,10 @@ var SEPARATOR_REGEX_NAMES_TO_VALUES = map[string]string{
 	"whitespace": WHITESPACE_REGEX,
 }
 
+// AUTO_INPUT_FORMAT is the input-format name for --auto-format, where the
+// format of each file is detected from its contents.
+const AUTO_INPUT_FORMAT = "auto"
+
 // E.g. if IFS isn't specified, it's space for NIDX and comma for DKVP, etc.
 
 var defaultFSes = map[string]string{
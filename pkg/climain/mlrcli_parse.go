@@ -370,7 +370,7 @@ func parseCommandLinePassTwo(
 	if cli.DecideFinalFlatten(&options.WriterOptions) {
 		// E.g. '{"req": {"method": "GET", "path": "/api/check"}}' becomes
 		// req.method=GET,req.path=/api/check.
-		transformer, err := transformers.NewTransformerFlatten(options.WriterOptions.FLATSEP, options, nil)
+		transformer, err := transformers.NewTransformerFlatten(options.WriterOptions.FLATSEP, options, nil, false)
 		lib.InternalCodingErrorIf(err != nil)
 		lib.InternalCodingErrorIf(transformer == nil)
 		recordTransformers = append(recordTransformers, transformer)
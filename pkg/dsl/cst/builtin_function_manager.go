@@ -57,6 +57,7 @@ type BuiltinFunctionInfo struct {
 	zaryFunc               bifs.ZaryFunc
 	unaryFunc              bifs.UnaryFunc
 	binaryFunc             bifs.BinaryFunc
+	binaryFuncInPlace      bifs.BinaryFuncInPlace // +, -, *
 	ternaryFunc            bifs.TernaryFunc
 	variadicFunc           bifs.VariadicFunc
 	unaryFuncWithContext   bifs.UnaryFuncWithContext   // asserting_{typename}
@@ -91,6 +92,7 @@ func makeBuiltinFunctionLookupTable() []BuiltinFunctionInfo {
 			help:               `Addition as binary operator; unary plus operator.`,
 			unaryFunc:          bifs.BIF_plus_unary,
 			binaryFunc:         bifs.BIF_plus_binary,
+			binaryFuncInPlace:  bifs.BIF_plus_binary_in_place,
 			hasMultipleArities: true,
 		},
 
@@ -100,14 +102,16 @@ func makeBuiltinFunctionLookupTable() []BuiltinFunctionInfo {
 			help:               `Subtraction as binary operator; unary negation operator.`,
 			unaryFunc:          bifs.BIF_minus_unary,
 			binaryFunc:         bifs.BIF_minus_binary,
+			binaryFuncInPlace:  bifs.BIF_minus_binary_in_place,
 			hasMultipleArities: true,
 		},
 
 		{
-			name:       "*",
-			class:      FUNC_CLASS_ARITHMETIC,
-			help:       `Multiplication, with integer*integer overflow to float.`,
-			binaryFunc: bifs.BIF_times,
+			name:              "*",
+			class:             FUNC_CLASS_ARITHMETIC,
+			help:              `Multiplication, with integer*integer overflow to float.`,
+			binaryFunc:        bifs.BIF_times,
+			binaryFuncInPlace: bifs.BIF_times_in_place,
 		},
 
 		{
@@ -169,7 +173,7 @@ func makeBuiltinFunctionLookupTable() []BuiltinFunctionInfo {
 		{
 			name:       "%",
 			class:      FUNC_CLASS_ARITHMETIC,
-			help:       `Remainder; never negative-valued (pythonic).`,
+			help:       `Remainder; zero or with the same sign as the divisor (pythonic). A float zero divisor gives NaN.`,
 			binaryFunc: bifs.BIF_modulus,
 		},
 
@@ -225,35 +229,35 @@ func makeBuiltinFunctionLookupTable() []BuiltinFunctionInfo {
 		{
 			name:      "bitcount",
 			class:     FUNC_CLASS_ARITHMETIC,
-			help:      "Count of 1-bits.",
+			help:      "Count of 1-bits. Negative integers are counted in 64-bit two's complement.",
 			unaryFunc: bifs.BIF_bitcount,
 		},
 
 		{
 			name:        "madd",
 			class:       FUNC_CLASS_ARITHMETIC,
-			help:        `a + b mod m (integers)`,
+			help:        `a + b mod m (integers). The modulus must be positive; intermediate results do not overflow.`,
 			ternaryFunc: bifs.BIF_mod_add,
 		},
 
 		{
 			name:        "msub",
 			class:       FUNC_CLASS_ARITHMETIC,
-			help:        `a - b mod m (integers)`,
+			help:        `a - b mod m (integers). The modulus must be positive; intermediate results do not overflow.`,
 			ternaryFunc: bifs.BIF_mod_sub,
 		},
 
 		{
 			name:        "mmul",
 			class:       FUNC_CLASS_ARITHMETIC,
-			help:        `a * b mod m (integers)`,
+			help:        `a * b mod m (integers). The modulus must be positive; intermediate results do not overflow.`,
 			ternaryFunc: bifs.BIF_mod_mul,
 		},
 
 		{
 			name:        "mexp",
 			class:       FUNC_CLASS_ARITHMETIC,
-			help:        `a ** b mod m (integers)`,
+			help:        `a ** b mod m (integers). The modulus must be positive; intermediate results do not overflow.`,
 			ternaryFunc: bifs.BIF_mod_exp,
 		},
 
@@ -323,9 +327,13 @@ func makeBuiltinFunctionLookupTable() []BuiltinFunctionInfo {
 			help: `String (left-hand side) matches regex (right-hand side), e.g.
 '$name =~ "^a.*b$"'.
 Capture groups \1 through \9 are matched from (...) in the right-hand side, and can be
-used within subsequent DSL statements. See also "Regular expressions" at ` + lib.DOC_URL + `.`,
+used within subsequent DSL statements. A number on the left-hand side is matched using its
+original string representation. A regex literal with a trailing i, as in "^a.*b$"i, is
+case-insensitive. See also "Regular expressions" at ` + lib.DOC_URL + `.`,
 			examples: []string{
 				`With if-statement: if ($url =~ "http.*com") { ... }`,
+				`"ABC" =~ "b"i is true`,
+				`0xff =~ "^0x" is true`,
 				`Without if-statement: given $line = "index ab09 file", and $line =~ "([a-z][a-z])([0-9][0-9])", then $label = "[\1:\2]", $label is "[ab:09]"`,
 			},
 			regexCaptureBinaryFunc: bifs.BIF_string_matches_regexp,
@@ -334,7 +342,7 @@ used within subsequent DSL statements. See also "Regular expressions" at ` + lib
 		{
 			name:                   "!=~",
 			class:                  FUNC_CLASS_BOOLEAN,
-			help:                   `String (left-hand side) does not match regex (right-hand side), e.g. '$name !=~ "^a.*b$"'.`,
+			help:                   `String (left-hand side) does not match regex (right-hand side), e.g. '$name !=~ "^a.*b$"'. As with =~, numbers on the left-hand side are matched as strings, and "..."i is case-insensitive.`,
 			regexCaptureBinaryFunc: bifs.BIF_string_does_not_match_regexp,
 		},
 
@@ -420,7 +428,7 @@ used within subsequent DSL statements. See also "Regular expressions" at ` + lib
 		{
 			name:        "?:",
 			class:       FUNC_CLASS_BOOLEAN,
-			help:        `Standard ternary operator.`,
+			help:        `Standard ternary operator: x ? y : z is y if x is true, else z. Only the selected one of y and z is evaluated. An absent condition gives absent; any other non-boolean condition is an error.`,
 			ternaryFunc: TernaryShortCircuitPlaceholder,
 		},
 
@@ -437,7 +445,7 @@ used within subsequent DSL statements. See also "Regular expressions" at ` + lib
 		{
 			name:      "capitalize",
 			class:     FUNC_CLASS_STRING,
-			help:      "Convert string's first character to uppercase.",
+			help:      "Convert string's first character to uppercase. Numbers are returned as-is; booleans, maps, and arrays are an error.",
 			unaryFunc: bifs.BIF_capitalize,
 		},
 
@@ -501,9 +509,14 @@ argument) doesn't match the pattern (second argument).`,
 		},
 
 		{
-			name:      "strlen",
-			class:     FUNC_CLASS_STRING,
-			help:      "String length.",
+			name:  "strlen",
+			class: FUNC_CLASS_STRING,
+			help:  "String length, in UTF-8 characters rather than bytes. Numbers are measured by their original string representation; booleans, maps, and arrays are an error.",
+			examples: []string{
+				`strlen("abc") gives 3.`,
+				`strlen("日本語") gives 3.`,
+				`strlen(12345) gives 5.`,
+			},
 			unaryFunc: bifs.BIF_strlen,
 		},
 
@@ -565,14 +578,25 @@ used within the same call to gsub -- they don't persist for subsequent DSL state
 			name:  "substr0",
 			class: FUNC_CLASS_STRING,
 			help: `substr0(s,m,n) gives substring of s from 0-up position m to n inclusive.
-Negative indices -len .. -1 alias to 0 .. len-1. See also substr and substr1.`,
+Negative indices -len .. -1 alias to 0 .. len-1. See also substr and substr1.
+Indices count UTF-8 characters, not bytes. Out-of-bounds indices are clamped, and m > n gives empty string.`,
+			examples: []string{
+				`substr0("héllo",0,1) gives "hé".`,
+				`substr0("héllo",-3,-1) gives "llo".`,
+			},
 			ternaryFunc: bifs.BIF_substr_0_up,
 		},
 		{
 			name:  "substr1",
 			class: FUNC_CLASS_STRING,
 			help: `substr1(s,m,n) gives substring of s from 1-up position m to n inclusive.
-Negative indices -len .. -1 alias to 1 .. len. See also substr and substr0.`,
+Negative indices -len .. -1 alias to 1 .. len. See also substr and substr0.
+Indices count UTF-8 characters, not bytes. Out-of-bounds indices are clamped, and m > n gives empty string.`,
+			examples: []string{
+				`substr1("héllo",1,2) gives "hé".`,
+				`substr1("héllo",-3,-1) gives "llo".`,
+				`substr1("héllo",4,10) gives "lo".`,
+			},
 			ternaryFunc: bifs.BIF_substr_1_up,
 		},
 		{
@@ -611,14 +635,14 @@ Arrays are new in Miller 6; the substr function is older.`,
 		{
 			name:      "tolower",
 			class:     FUNC_CLASS_STRING,
-			help:      "Convert string to lowercase.",
+			help:      "Convert string to lowercase. Numbers are returned as-is; booleans, maps, and arrays are an error.",
 			unaryFunc: bifs.BIF_tolower,
 		},
 
 		{
 			name:      "toupper",
 			class:     FUNC_CLASS_STRING,
-			help:      "Convert string to uppercase.",
+			help:      "Convert string to uppercase. Numbers are returned as-is; booleans, maps, and arrays are an error.",
 			unaryFunc: bifs.BIF_toupper,
 		},
 
@@ -867,8 +891,14 @@ If argument is array or map, recurses into it.`,
 		{
 			name:  "invqnorm",
 			class: FUNC_CLASS_MATH,
-			help: `Inverse of normal cumulative distribution function.  Note that invqorm(urand())
-is normally distributed.`,
+			help: `Inverse of normal cumulative distribution function.  Note that invqnorm(urand())
+is normally distributed. The result is found iteratively, stopping once qnorm of it is within 1e-9 of
+the input; this is an absolute error bound, so accuracy falls off for inputs very near 0 or 1. Inputs
+outside the open interval (0,1) give 0.`,
+			examples: []string{
+				"invqnorm(0.5) gives 0.",
+				"invqnorm(0.975) gives approximately 1.96.",
+			},
 			unaryFunc: bifs.BIF_invqnorm,
 		},
 
@@ -894,30 +924,47 @@ is normally distributed.`,
 		},
 
 		{
-			name:        "logifit",
-			class:       FUNC_CLASS_MATH,
-			help:        `Given m and b from logistic regression, compute fit: $yhat=logifit($x,$m,$b).`,
+			name:  "logifit",
+			class: FUNC_CLASS_MATH,
+			help:  `Given m and b from logistic regression, compute fit: $yhat=logifit($x,$m,$b). This is 1/(1+exp(-m*x-b)).`,
+			examples: []string{
+				"logifit(2,3,-6) gives 0.5.",
+			},
 			ternaryFunc: bifs.BIF_logifit,
 		},
 
 		{
-			name:         "max",
-			class:        FUNC_CLASS_MATH,
-			help:         `Max of n numbers; null loses. The min and max functions also recurse into arrays and maps, so they can be used to get min/max stats on array/map values.`,
+			name:  "max",
+			class: FUNC_CLASS_MATH,
+			help:  `Max of n numbers; absent loses, and if all arguments are absent, the result is absent. Mixed types are ordered numerical values < booleans < empty < other strings. The min and max functions also recurse into arrays and maps, so they can be used to get min/max stats on array/map values.`,
+			examples: []string{
+				`max(1,2.5,-3) gives 2.5.`,
+				`max(1,"abc") gives "abc".`,
+				`max(1,"") gives "".`,
+			},
 			variadicFunc: bifs.BIF_max_variadic,
 		},
 
 		{
-			name:         "min",
-			class:        FUNC_CLASS_MATH,
-			help:         `Min of n numbers; null loses. The min and max functions also recurse into arrays and maps, so they can be used to get min/max stats on array/map values.`,
+			name:  "min",
+			class: FUNC_CLASS_MATH,
+			help:  `Min of n numbers; absent loses, and if all arguments are absent, the result is absent. Mixed types are ordered numerical values < booleans < empty < other strings. The min and max functions also recurse into arrays and maps, so they can be used to get min/max stats on array/map values.`,
+			examples: []string{
+				`min(1,2.5,-3) gives -3.`,
+				`min(1,"abc") gives 1.`,
+				`min(1,"") gives 1.`,
+			},
 			variadicFunc: bifs.BIF_min_variadic,
 		},
 
 		{
-			name:      "qnorm",
-			class:     FUNC_CLASS_MATH,
-			help:      `Normal cumulative distribution function.`,
+			name:  "qnorm",
+			class: FUNC_CLASS_MATH,
+			help:  `Normal cumulative distribution function.`,
+			examples: []string{
+				"qnorm(0) gives 0.5.",
+				"qnorm(1.96) gives approximately 0.975.",
+			},
 			unaryFunc: bifs.BIF_qnorm,
 		},
 
@@ -990,7 +1037,7 @@ is normally distributed.`,
 		{
 			name:       "urandint",
 			class:      FUNC_CLASS_MATH,
-			help:       `Integer uniformly distributed between inclusive integer endpoints.`,
+			help:       `Integer uniformly distributed between inclusive integer endpoints a and b. It is an error if a is greater than b.`,
 			binaryFunc: bifs.BIF_urandint,
 		},
 
@@ -1004,7 +1051,7 @@ is normally distributed.`,
 		{
 			name:     "urand32",
 			class:    FUNC_CLASS_MATH,
-			help:     `Integer uniformly distributed 0 and 2**32-1 inclusive.`,
+			help:     `Integer uniformly distributed between 0 and 2**32-1 inclusive.`,
 			zaryFunc: bifs.BIF_urand32,
 		},
 
@@ -1547,12 +1594,14 @@ See also strftime_local.`,
 		{
 			name:  "strptime",
 			class: FUNC_CLASS_TIME,
-			help:  `strptime: Parses timestamp as floating-point seconds since the epoch. See also strptime_local.`,
+			help:  `strptime: Parses timestamp as floating-point seconds since the epoch. Fractional seconds in the input are accepted by "%S". See also strptime_local.`,
 			examples: []string{
 				`strptime("2015-08-28T13:33:21Z",      "%Y-%m-%dT%H:%M:%SZ")   = 1440768801.000000`,
 				`strptime("2015-08-28T13:33:21.345Z",  "%Y-%m-%dT%H:%M:%SZ")   = 1440768801.345000`,
+				`strptime("2015-08-28 13:33:21.345",   "%Y-%m-%d %H:%M:%S")    = 1440768801.345000`,
 				`strptime("1970-01-01 00:00:00 -0400", "%Y-%m-%d %H:%M:%S %z") = 14400`,
 				`strptime("1970-01-01 00:00:00 +0200", "%Y-%m-%d %H:%M:%S %z") = -7200`,
+				`strptime(20230115, "%Y%m%d") = 1673740800`,
 			},
 			binaryFunc: bifs.BIF_strptime,
 		},
@@ -1566,6 +1615,7 @@ See also strftime_local.`,
 				`strpntime("2015-08-28T13:33:21.345Z",  "%Y-%m-%dT%H:%M:%SZ")   = 1440768801345000000`,
 				`strpntime("1970-01-01 00:00:00 -0400", "%Y-%m-%d %H:%M:%S %z") = 14400000000000`,
 				`strpntime("1970-01-01 00:00:00 +0200", "%Y-%m-%d %H:%M:%S %z") = -7200000000000`,
+				`strpntime(20230115, "%Y%m%d") = 1673740800000000000`,
 			},
 			binaryFunc: bifs.BIF_strpntime,
 		},
@@ -1579,8 +1629,7 @@ See also strftime_local.`,
 				`strptime_local("2015-08-28T13:33:21.345Z","%Y-%m-%dT%H:%M:%SZ") = 1440758001.345 with TZ="Asia/Istanbul"`,
 				`strptime_local("2015-08-28 13:33:21",     "%Y-%m-%d %H:%M:%S")  = 1440758001     with TZ="Asia/Istanbul"`,
 				`strptime_local("2015-08-28 13:33:21",     "%Y-%m-%d %H:%M:%S", "Asia/Istanbul") = 1440758001`,
-				// TODO: fix parse error on decimal part
-				//`strptime_local("2015-08-28 13:33:21.345","%Y-%m-%d %H:%M:%S") = 1440758001.345`,
+				`strptime_local("2015-08-28 13:33:21.345", "%Y-%m-%d %H:%M:%S")  = 1440758001.345 with TZ="Asia/Istanbul"`,
 			},
 			binaryFunc:         bifs.BIF_strptime_local_binary,
 			ternaryFunc:        bifs.BIF_strptime_local_ternary,
@@ -1596,8 +1645,7 @@ See also strftime_local.`,
 				`strpntime_local("2015-08-28T13:33:21.345Z","%Y-%m-%dT%H:%M:%SZ") = 1440758001345000000 with TZ="Asia/Istanbul"`,
 				`strpntime_local("2015-08-28 13:33:21",     "%Y-%m-%d %H:%M:%S")  = 1440758001000000000 with TZ="Asia/Istanbul"`,
 				`strpntime_local("2015-08-28 13:33:21",     "%Y-%m-%d %H:%M:%S", "Asia/Istanbul") = 1440758001000000000`,
-				// TODO: fix parse error on decimal part
-				//`strpntime_local("2015-08-28 13:33:21.345","%Y-%m-%d %H:%M:%S") = 1440758001.345`,
+				`strpntime_local("2015-08-28 13:33:21.345", "%Y-%m-%d %H:%M:%S")  = 1440758001345000000 with TZ="Asia/Istanbul"`,
 			},
 			binaryFunc:         bifs.BIF_strpntime_local_binary,
 			ternaryFunc:        bifs.BIF_strpntime_local_ternary,
@@ -1621,14 +1669,14 @@ See also strftime_local.`,
 		{
 			name:      "fsec2dhms",
 			class:     FUNC_CLASS_TIME,
-			help:      `Formats floating-point seconds as in fsec2dhms(500000.25) = "5d18h53m20.250000s"`,
+			help:      `Formats floating-point seconds as in fsec2dhms(500000.25) = "5d18h53m20.250000s". Output is rounded to microseconds; negative inputs get a leading minus sign.`,
 			unaryFunc: bifs.BIF_fsec2dhms,
 		},
 
 		{
 			name:      "fsec2hms",
 			class:     FUNC_CLASS_TIME,
-			help:      `Formats floating-point seconds as in fsec2hms(5000.25) = "01:23:20.250000"`,
+			help:      `Formats floating-point seconds as in fsec2hms(5000.25) = "01:23:20.250000". Output is rounded to microseconds; hours are not wrapped at 24, so fsec2hms(86400) = "24:00:00.000000".`,
 			unaryFunc: bifs.BIF_fsec2hms,
 		},
 
@@ -1642,7 +1690,7 @@ See also strftime_local.`,
 		{
 			name:      "hms2sec",
 			class:     FUNC_CLASS_TIME,
-			help:      `Recovers integer seconds as in hms2sec("01:23:20") = 5000`,
+			help:      `Recovers integer seconds as in hms2sec("01:23:20") = 5000. Input with anything other than hours, minutes, and seconds is an error.`,
 			unaryFunc: bifs.BIF_hms2sec,
 		},
 
@@ -1656,7 +1704,7 @@ See also strftime_local.`,
 		{
 			name:      "sec2hms",
 			class:     FUNC_CLASS_TIME,
-			help:      `Formats integer seconds as in sec2hms(5000) = "01:23:20"`,
+			help:      `Formats integer seconds as in sec2hms(5000) = "01:23:20". Hours are not wrapped at 24, so sec2hms(86400) = "24:00:00".`,
 			unaryFunc: bifs.BIF_sec2hms,
 		},
 
@@ -2025,9 +2073,16 @@ Note that NaN has the property that NaN != NaN, so you need 'is_nan(x)' rather t
 		},
 
 		{
-			name:      "hexfmt",
-			class:     FUNC_CLASS_CONVERSION,
-			help:      `Convert int to hex string, e.g. 255 to "0xff".`,
+			name:  "hexfmt",
+			class: FUNC_CLASS_CONVERSION,
+			help: `Convert int to hex string, e.g. 255 to "0xff". Negative ints are shown in two's
+complement. Absent and empty inputs are returned as-is; other non-int inputs, such as
+floats and strings, are an error.`,
+			examples: []string{
+				`hexfmt(255) gives "0xff".`,
+				`hexfmt(-1) gives "0xffffffffffffffff".`,
+				`hexfmt(3.5) gives an error.`,
+			},
 			unaryFunc: bifs.BIF_hexfmt,
 		},
 
@@ -2037,7 +2092,9 @@ Note that NaN has the property that NaN != NaN, so you need 'is_nan(x)' rather t
 			help: `Convert int/float/bool/string to int.
 If the second argument is omitted and the first argument is a string, base is inferred from the first argument's prefix.
 If the second argument is provided and the first argument is a string, the second argument is used as the base.
-If the second argument is provided and the first argument is not a string, the second argument is ignored.`,
+If the second argument is provided and the first argument is not a string, the second argument is ignored.
+Floats are truncated toward zero; NaN, and floats outside the 64-bit int range, give an error, as do
+strings which don't parse as int.`,
 
 			unaryFunc:          bifs.BIF_int,
 			binaryFunc:         bifs.BIF_int_with_base,
@@ -2050,6 +2107,8 @@ If the second argument is provided and the first argument is not a string, the s
 				`int("0377", 10) gives decimal 377`,
 				`int(345, 16) gives decimal 345`,
 				`int(string(345), 16) gives decimal 837`,
+				`int(-3.9) gives -3`,
+				`int("abc") gives an error`,
 			},
 		},
 
@@ -2091,9 +2150,12 @@ second is pair-separator string; third is field-separator string. Mnemonic: the
 			name:  "splita",
 			class: FUNC_CLASS_CONVERSION,
 			help: `Splits string into array with type inference. First argument is string to split;
-second is the separator to split on.`,
+second is the separator to split on. Numbers are split on their string representation.
+The empty string splits to an empty array.`,
 			examples: []string{
 				`splita("3,4,5", ",") = [3,4,5]`,
+				`splita(12345, "3") = [12,45]`,
+				`splita("", ",") = []`,
 			},
 			binaryFunc: bifs.BIF_splita,
 		},
@@ -2102,9 +2164,11 @@ second is the separator to split on.`,
 			name:  "splitax",
 			class: FUNC_CLASS_CONVERSION,
 			help: `Splits string into array without type inference. First argument is string to split;
-second is the separator to split on.`,
+second is the separator to split on. Numbers are split on their string representation.
+The empty string splits to an empty array.`,
 			examples: []string{
 				`splitax("3,4,5", ",") = ["3","4","5"]`,
+				`splitax("", ",") = []`,
 			},
 			binaryFunc: bifs.BIF_splitax,
 		},
@@ -2136,9 +2200,10 @@ argument is pair separator; third argument is field separator.`,
 			name:  "splitnv",
 			class: FUNC_CLASS_CONVERSION,
 			help: `Splits string by separator into integer-indexed map with type inference. First argument is
-string to split; second argument is separator to split on.`,
+string to split; second argument is separator to split on. The empty string splits to an empty map.`,
 			examples: []string{
 				`splitnv("a,b,c", ",") = {"1":"a","2":"b","3":"c"}`,
+				`splitnv("", ",") = {}`,
 			},
 			binaryFunc: bifs.BIF_splitnv,
 		},
@@ -2148,7 +2213,7 @@ string to split; second argument is separator to split on.`,
 			class: FUNC_CLASS_CONVERSION,
 			help: `Splits string by separator into integer-indexed map without
 type inference (values are strings). First argument is string to split; second
-argument is separator to split on.`,
+argument is separator to split on. The empty string splits to an empty map.`,
 			examples: []string{
 				`splitnvx("3,4,5", ",") = {"1":"3","2":"4","3":"5"}`,
 			},
@@ -2233,16 +2298,23 @@ information.`,
 		},
 
 		{
-			name:      "get_keys",
-			class:     FUNC_CLASS_COLLECTIONS,
-			help:      "Returns array of keys of map or array",
+			name:  "get_keys",
+			class: FUNC_CLASS_COLLECTIONS,
+			help:  "Returns array of keys of map or array. For arrays, the keys are the 1-up indices.",
+			examples: []string{
+				`get_keys({"a":1,"b":{"c":2}}) is ["a", "b"].`,
+				`get_keys([7,8,9]) is [1, 2, 3].`,
+			},
 			unaryFunc: bifs.BIF_get_keys,
 		},
 
 		{
-			name:      "get_values",
-			class:     FUNC_CLASS_COLLECTIONS,
-			help:      "Returns array of values of map or array -- in the latter case, returns a copy of the array",
+			name:  "get_values",
+			class: FUNC_CLASS_COLLECTIONS,
+			help:  "Returns array of values of map or array -- in the latter case, returns a copy of the array",
+			examples: []string{
+				`get_values({"a":1,"b":{"c":2}}) is [1, {"c": 2}].`,
+			},
 			unaryFunc: bifs.BIF_get_values,
 		},
 
@@ -2252,6 +2324,11 @@ information.`,
 			help: `True/false if map has/hasn't key, e.g. 'haskey($*, "a")' or 'haskey(mymap, mykey)',
 or true/false if array index is in bounds / out of bounds.  Error if 1st argument is not a map or array. Note
 -n..-1 alias to 1..n in Miller arrays.`,
+			examples: []string{
+				`haskey({"a":1}, "a") is true.`,
+				`haskey([7,8,9], -3) is true.`,
+				`haskey([7,8,9], 0) is false.`,
+			},
 			binaryFunc: bifs.BIF_haskey,
 		},
 
@@ -2279,9 +2356,15 @@ With optional second boolean argument set to true, produces multiline output.`,
 		},
 
 		{
-			name:      "length",
-			class:     FUNC_CLASS_COLLECTIONS,
-			help:      "Counts number of top-level entries in array/map. Scalars have length 1.",
+			name:  "length",
+			class: FUNC_CLASS_COLLECTIONS,
+			help:  "Counts number of top-level entries in array/map. Scalars have length 1; absent has length 0.",
+			examples: []string{
+				`length({"a":1,"b":{"c":2}}) is 2.`,
+				`length([]) is 0.`,
+				`length("abc") is 1.`,
+				`length($nosuchfield) is 0.`,
+			},
 			unaryFunc: bifs.BIF_length,
 		},
 
@@ -2289,7 +2372,8 @@ With optional second boolean argument set to true, produces multiline output.`,
 			name:  "mapdiff",
 			class: FUNC_CLASS_COLLECTIONS,
 			help: `With 0 args, returns empty map. With 1 arg, returns copy of arg.  With 2 or more,
-returns copy of arg 1 with all keys from any of remaining argument maps removed.`,
+returns copy of arg 1 with all keys from any of remaining argument maps removed.
+All arguments must be maps.`,
 			variadicFunc: bifs.BIF_mapdiff,
 		},
 
@@ -2297,18 +2381,29 @@ returns copy of arg 1 with all keys from any of remaining argument maps removed.
 			name:  "mapexcept",
 			class: FUNC_CLASS_COLLECTIONS,
 			help: `Returns a map with keys from remaining arguments, if any, unset.
-Remaining arguments can be strings or arrays of string.  E.g. 'mapexcept({1:2,3:4,5:6}, 1, 5, 7)' is '{3:4}'
+Remaining arguments can be strings, ints, or arrays thereof.  E.g. 'mapexcept({1:2,3:4,5:6}, 1, 5, 7)' is '{3:4}'
 and  'mapexcept({1:2,3:4,5:6}, [1, 5, 7])' is '{3:4}'.`,
 			variadicFunc:         bifs.BIF_mapexcept,
 			minimumVariadicArity: 1,
 		},
 
+		{
+			name:  "maponly",
+			class: FUNC_CLASS_COLLECTIONS,
+			help: `Returns a map with only keys from remaining arguments set, in the order
+they appear in the first argument. Remaining arguments can be strings, ints, or arrays thereof.
+E.g. 'maponly({1:2,3:4,5:6}, 1, 5, 7)' is '{1:2,5:6}'. Synonymous with mapselect.`,
+			variadicFunc:         bifs.BIF_maponly,
+			minimumVariadicArity: 1,
+		},
+
 		{
 			name:  "mapselect",
 			class: FUNC_CLASS_COLLECTIONS,
-			help: `Returns a map with only keys from remaining arguments set.
-Remaining arguments can be strings or arrays of string.  E.g. 'mapselect({1:2,3:4,5:6}, 1, 5, 7)' is
-'{1:2,5:6}' and  'mapselect({1:2,3:4,5:6}, [1, 5, 7])' is '{1:2,5:6}'.`,
+			help: `Returns a map with only keys from remaining arguments set, in the order
+they appear in the first argument. Remaining arguments can be strings, ints, or arrays thereof.
+E.g. 'mapselect({1:2,3:4,5:6}, 1, 5, 7)' is '{1:2,5:6}' and 'mapselect({1:2,3:4,5:6}, [1, 5, 7])'
+is '{1:2,5:6}'. Synonymous with maponly.`,
 			variadicFunc:         bifs.BIF_mapselect,
 			minimumVariadicArity: 1,
 		},
@@ -2317,10 +2412,35 @@ Remaining arguments can be strings or arrays of string.  E.g. 'mapselect({1:2,3:
 			name:  "mapsum",
 			class: FUNC_CLASS_COLLECTIONS,
 			help: `With 0 args, returns empty map. With >= 1 arg, returns a map with key-value pairs
-from all arguments. Rightmost collisions win, e.g.  'mapsum({1:2,3:4},{1:5})' is '{1:5,3:4}'.`,
+from all arguments. Rightmost collisions win, e.g.  'mapsum({1:2,3:4},{1:5})' is '{1:5,3:4}'.
+Keys keep their first-seen order. All arguments must be maps.`,
 			variadicFunc: bifs.BIF_mapsum,
 		},
 
+		{
+			name:  "sort_by_key",
+			class: FUNC_CLASS_COLLECTIONS,
+			help: `Given a map, returns a copy with entries sorted ascending by key: numeric keys
+first, numerically, then the rest lexically. This is the same as sort with a single map
+argument; please see sort for reverse, case-folded, and other orderings.`,
+			unaryFunc: SortByKey,
+			examples: []string{
+				`sort_by_key({"c":2,"10":3,"b":1,"9":4}) is {"9":4,"10":3,"b":1,"c":2}.`,
+			},
+		},
+
+		{
+			name:  "sort_by_value",
+			class: FUNC_CLASS_COLLECTIONS,
+			help: `Given a map, returns a copy with entries sorted ascending by value: numbers
+first, numerically, then strings lexically. This is the same as sort with "v" flag; please
+see sort for reverse, case-folded, and other orderings.`,
+			unaryFunc: SortByValue,
+			examples: []string{
+				`sort_by_value({"c":2,"a":3,"b":1}) is {"b":1,"c":2,"a":3}.`,
+			},
+		},
+
 		// ----------------------------------------------------------------
 		// FUNC_CLASS_HOFS
 
@@ -2399,7 +2519,7 @@ argument is a function, then for arrays it should take two arguments a and b, re
 bk, and bv, again returning < 0, 0, or
 > 0, using a and b's keys and values.`,
 			examples: []string{
-				`Default sorting: sort([3,"A",1,"B",22]) returns [1, 3, 20, "A", "B"].`,
+				`Default sorting: sort([3,"A",1,"B",22]) returns [1, 3, 22, "A", "B"].`,
 				`  Note that this is numbers before strings.`,
 				`Default sorting: sort(["E","a","c","B","d"]) returns ["B", "E", "a", "c", "d"].`,
 				`  Note that this is uppercase before lowercase.`,
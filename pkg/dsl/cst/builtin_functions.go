@@ -252,6 +252,15 @@ func (root *RootNode) BuildBinaryFunctionCallsiteNode(
 		), nil
 	}
 
+	if builtinFunctionInfo.binaryFuncInPlace != nil {
+		return BuildArithmeticOperatorNode(
+			astNode,
+			builtinFunctionInfo,
+			evaluable1,
+			evaluable2,
+		), nil
+	}
+
 	return &BinaryFunctionCallsiteNode{
 		binaryFunc: builtinFunctionInfo.binaryFunc,
 		evaluable1: evaluable1,
@@ -268,6 +277,97 @@ func (node *BinaryFunctionCallsiteNode) Evaluate(
 	)
 }
 
+// ----------------------------------------------------------------
+// ArithmeticOperatorNode is for the binary +, -, and * operators. These are
+// the most common in hot loops, and each evaluation allocates a new mlrval for
+// its output. But when an operand of one of these is itself one of these, as
+// in '$x * 2 + $y * 3', the operand's output is used only as input to the
+// parent and is dropped right after. Such operands write their output into a
+// scratch mlrval which they own, and which is reused from one record to the
+// next.
+//
+// A scratch mlrval must never escape to anything which would hold on to it.
+// So the parent is responsible for copying its operand's scratch when the
+// operator passes an input through as output, e.g. 1 + absent is 1.
+
+type ArithmeticOperatorNode struct {
+	binaryFunc        bifs.BinaryFunc
+	binaryFuncInPlace bifs.BinaryFuncInPlace
+	evaluable1        IEvaluable
+	evaluable2        IEvaluable
+
+	// Non-nil when the parent node consumes this node's output right away
+	scratch *mlrval.Mlrval
+	// Non-nil when the respective operand writes its output into scratch
+	operandScratch1 *mlrval.Mlrval
+	operandScratch2 *mlrval.Mlrval
+}
+
+func BuildArithmeticOperatorNode(
+	astNode *dsl.ASTNode,
+	builtinFunctionInfo *BuiltinFunctionInfo,
+	evaluable1 IEvaluable,
+	evaluable2 IEvaluable,
+) *ArithmeticOperatorNode {
+	node := &ArithmeticOperatorNode{
+		binaryFunc:        builtinFunctionInfo.binaryFunc,
+		binaryFuncInPlace: builtinFunctionInfo.binaryFuncInPlace,
+		evaluable1:        evaluable1,
+		evaluable2:        evaluable2,
+	}
+
+	// The left operand's output must remain intact while the right operand
+	// is evaluated. If the right operand has a function call in it, that
+	// could recurse back to the left operand and overwrite its scratch.
+	operand1, ok := evaluable1.(*ArithmeticOperatorNode)
+	if ok && !hasCallsite(astNode.Children[1]) {
+		operand1.scratch = &mlrval.Mlrval{}
+		node.operandScratch1 = operand1.scratch
+	}
+
+	// The right operand is evaluated last, so its output is consumed right
+	// away.
+	operand2, ok := evaluable2.(*ArithmeticOperatorNode)
+	if ok {
+		operand2.scratch = &mlrval.Mlrval{}
+		node.operandScratch2 = operand2.scratch
+	}
+
+	return node
+}
+
+func (node *ArithmeticOperatorNode) Evaluate(
+	state *runtime.State,
+) *mlrval.Mlrval {
+	input1 := node.evaluable1.Evaluate(state)
+	input2 := node.evaluable2.Evaluate(state)
+
+	if node.scratch != nil {
+		return node.binaryFuncInPlace(node.scratch, input1, input2)
+	}
+
+	output := node.binaryFunc(input1, input2)
+	if output == node.operandScratch1 || output == node.operandScratch2 {
+		return output.Copy()
+	}
+	return output
+}
+
+// hasCallsite is for ArithmeticOperatorNode, to see if evaluating an AST
+// subtree could call a user-defined function, directly or by way of a
+// higher-order function.
+func hasCallsite(astNode *dsl.ASTNode) bool {
+	if astNode.Type == dsl.NodeTypeFunctionCallsite || astNode.Type == dsl.NodeTypeSubroutineCallsite {
+		return true
+	}
+	for _, child := range astNode.Children {
+		if hasCallsite(child) {
+			return true
+		}
+	}
+	return false
+}
+
 // ----------------------------------------------------------------
 type BinaryFunctionWithStateCallsiteNode struct {
 	binaryFuncWithState BinaryFuncWithState
@@ -713,89 +813,14 @@ func BuildLogicalANDOperatorNode(a, b IEvaluable) *LogicalANDOperatorNode {
 // This is different from most of the evaluator functions in that it does
 // short-circuiting: since is logical AND, the second argument is not evaluated
 // if the first argument is false. Thus we cannot use disposition matrices.
-//
-// * evaluate a
-// * if   a is error:
-//   * return a
-// * elif a is absent:
-//   * Evaluate b
-//   * if   b is error: return error
-//   * elif b is empty or absent: return absent
-//   * elif b is empty or absent: return absent
-//   * else: return b
-// * elif a is empty:
-//   * evaluate b
-//   * if   b is error: return error
-//   * elif b is empty: return empty
-//   * elif b is absent: return absent
-//   * else: return b
-// * else:
-//   * return the BIF (using its disposition matrix)
-
-// mlr help type-arithmetic-info-extended | lumin -c red .error. | lumin -c blue .absent. | lumin -c green .empty.
-
+// See bifs.BIF_logical_AND_lazy for the rules.
 func (node *LogicalANDOperatorNode) Evaluate(
 	state *runtime.State,
 ) *mlrval.Mlrval {
-	aout := node.a.Evaluate(state)
-	atype := aout.Type()
-
-	if atype == mlrval.MT_ERROR {
-		return aout
-	}
-
-	if atype == mlrval.MT_ABSENT {
-		bout := node.b.Evaluate(state)
-		btype := bout.Type()
-		if btype == mlrval.MT_ERROR {
-			return bout
-		}
-		if btype == mlrval.MT_VOID || btype == mlrval.MT_ABSENT {
-			return mlrval.ABSENT
-		}
-		if btype != mlrval.MT_BOOL {
-			return mlrval.FromNotNamedTypeError("&&", bout, "absent or boolean")
-		}
-		return bout
-	}
-
-	if atype == mlrval.MT_VOID {
-		bout := node.b.Evaluate(state)
-		btype := bout.Type()
-		if btype == mlrval.MT_ERROR {
-			return bout
-		}
-		if btype == mlrval.MT_VOID {
-			return mlrval.FromNotNamedTypeError("&&", bout, "absent or boolean")
-		}
-		if btype == mlrval.MT_ABSENT {
-			return mlrval.ABSENT
-		}
-		if btype != mlrval.MT_BOOL {
-			return mlrval.FromNotNamedTypeError("&&", bout, "absent or boolean")
-		}
-		return bout
-	}
-
-	// - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
-	if aout.IsFalse() {
-		// This means false && bogus type evaluates to false, which is sad but
-		// which we MUST do in order to not violate the short-circuiting
-		// property.  We would have to evaluate b to know if it were error or
-		// not.
-		return aout
-	}
-
-	bout := node.b.Evaluate(state)
-	btype := bout.Type()
-	if !(btype == mlrval.MT_ABSENT || btype == mlrval.MT_BOOL) {
-		return mlrval.FromNotNamedTypeError("&&", bout, "absent or boolean")
-	}
-	if btype == mlrval.MT_ABSENT {
-		return mlrval.ABSENT
-	}
-
-	return bifs.BIF_logical_AND(aout, bout)
+	return bifs.BIF_logical_AND_lazy(
+		node.a.Evaluate(state),
+		func() *mlrval.Mlrval { return node.b.Evaluate(state) },
+	)
 }
 
 // ================================================================
@@ -812,73 +837,14 @@ func BuildLogicalOROperatorNode(a, b IEvaluable) *LogicalOROperatorNode {
 
 // This is different from most of the evaluator functions in that it does
 // short-circuiting: since is logical OR, the second argument is not evaluated
-// if the first argument is false.
-
+// if the first argument is true. See bifs.BIF_logical_OR_lazy for the rules.
 func (node *LogicalOROperatorNode) Evaluate(
 	state *runtime.State,
 ) *mlrval.Mlrval {
-	aout := node.a.Evaluate(state)
-	atype := aout.Type()
-
-	if atype == mlrval.MT_ERROR {
-		return aout
-	}
-
-	if atype == mlrval.MT_ABSENT {
-		bout := node.b.Evaluate(state)
-		btype := bout.Type()
-		if btype == mlrval.MT_ERROR {
-			return bout
-		}
-		if btype == mlrval.MT_VOID || btype == mlrval.MT_ABSENT {
-			return mlrval.ABSENT
-		}
-		if btype == mlrval.MT_VOID {
-			return mlrval.FromNotNamedTypeError("||", bout, "absent or boolean")
-		}
-		if btype != mlrval.MT_BOOL {
-			return mlrval.FromNotNamedTypeError("||", bout, "absent or boolean")
-		}
-		return bout
-	}
-
-	if atype == mlrval.MT_VOID {
-		bout := node.b.Evaluate(state)
-		btype := bout.Type()
-		if btype == mlrval.MT_ERROR {
-			return bout
-		}
-		if btype == mlrval.MT_VOID {
-			return mlrval.FromNotNamedTypeError("||", bout, "absent or boolean")
-		}
-		if btype == mlrval.MT_ABSENT {
-			return mlrval.ABSENT
-		}
-		if btype != mlrval.MT_BOOL {
-			return mlrval.FromNotNamedTypeError("||", bout, "absent or boolean")
-		}
-		return bout
-	}
-
-	// - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
-	if aout.IsTrue() {
-		// This means true || bogus type evaluates to true, which is sad but
-		// which we MUST do in order to not violate the short-circuiting
-		// property.  We would have to evaluate b to know if it were error or
-		// not.
-		return aout
-	}
-
-	bout := node.b.Evaluate(state)
-	btype := bout.Type()
-	if !(btype == mlrval.MT_ABSENT || btype == mlrval.MT_BOOL) {
-		return mlrval.FromNotNamedTypeError("||", bout, "absent or boolean")
-	}
-	if btype == mlrval.MT_ABSENT {
-		return mlrval.ABSENT
-	}
-
-	return bifs.BIF_logical_OR(aout, bout)
+	return bifs.BIF_logical_OR_lazy(
+		node.a.Evaluate(state),
+		func() *mlrval.Mlrval { return node.b.Evaluate(state) },
+	)
 }
 
 // ================================================================
@@ -939,6 +905,10 @@ func (node *StandardTernaryOperatorNode) Evaluate(
 ) *mlrval.Mlrval {
 	aout := node.a.Evaluate(state)
 
+	// Same semantics as bifs.BIF_ternary, but evaluating only one of b and c
+	if aout.IsAbsent() {
+		return aout
+	}
 	boolValue, isBool := aout.GetBoolValue()
 	if !isBool {
 		return mlrval.FromNotBooleanError("?:", aout)
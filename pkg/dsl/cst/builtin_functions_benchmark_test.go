@@ -0,0 +1,40 @@
+package cst
+
+import (
+	"testing"
+
+	"github.com/johnkerl/miller/pkg/cli"
+	"github.com/johnkerl/miller/pkg/mlrval"
+	"github.com/johnkerl/miller/pkg/runtime"
+	"github.com/johnkerl/miller/pkg/types"
+)
+
+// go test -run=nonesuch -bench=. -benchmem github.com/johnkerl/miller/pkg/dsl/cst/...
+
+func benchmarkMainBlock(b *testing.B, dslString string) {
+	options := cli.DefaultOptions()
+	root := NewEmptyRoot(&options.WriterOptions, DSLInstanceTypePut)
+	_, err := root.Build([]string{dslString}, DSLInstanceTypePut, false, false, nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	state := runtime.NewEmptyState(options, false)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		inrec := mlrval.NewMlrmapAsRecord()
+		inrec.PutReference("x", mlrval.FromDeferredType("0.25"))
+		inrec.PutReference("y", mlrval.FromDeferredType("17"))
+		state.Update(inrec, types.NewContext())
+		_, err := root.ExecuteMainBlock(state)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// All four inner operators write to scratch mlrvals rather than allocating
+func BenchmarkArithmeticNested(b *testing.B) {
+	benchmarkMainBlock(b, "$z = $x * 2 + $y * 3 - ($x - $y)")
+}
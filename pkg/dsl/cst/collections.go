@@ -318,7 +318,7 @@ func (node *PositionalFieldValueNode) Evaluate(
 		return mlrval.ABSENT.StrictModeCheck(state.StrictMode, "$[[["+indexMlrval.String()+"]]]")
 	}
 
-	return retval
+	return fieldValueForRead(state, retval)
 }
 
 // ================================================================
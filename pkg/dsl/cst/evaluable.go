@@ -131,7 +131,7 @@ func (node *IndirectFieldValueNode) Evaluate(
 	if value == nil {
 		return mlrval.ABSENT.StrictModeCheck(state.StrictMode, "$["+fieldName.String()+"]")
 	}
-	return value
+	return fieldValueForRead(state, value)
 }
 
 // ----------------------------------------------------------------
@@ -398,7 +398,7 @@ func reduceArray(
 		argsArray[0] = accumulator
 		argsArray[1] = inputArray[i]
 		accumulator = (udfCallsite.EvaluateWithArguments(state, udfCallsite.udf, argsArray))
-		isNonAbsentOrDie(accumulator, "apply")
+		isNonAbsentOrDie(accumulator, "reduce")
 	}
 	return accumulator
 }
@@ -475,7 +475,7 @@ func foldArray(
 		argsArray[0] = accumulator
 		argsArray[1] = inputArray[i]
 		accumulator = (udfCallsite.EvaluateWithArguments(state, udfCallsite.udf, argsArray))
-		isNonAbsentOrDie(accumulator, "apply")
+		isNonAbsentOrDie(accumulator, "fold")
 	}
 	return accumulator
 }
@@ -496,11 +496,7 @@ func foldMap(
 	udfCallsite := hofSpace.udfCallsite
 	argsArray := hofSpace.argsArray
 
-	if inputMap.IsEmpty() {
-		return mlrval.ABSENT
-	}
-
-	accumulator := getKVPairForAccumulatorOrDie(input3, "reduce").Copy()
+	accumulator := getKVPairForAccumulatorOrDie(input3, "fold").Copy()
 
 	for pe := inputMap.Head; pe != nil; pe = pe.Next {
 		argsArray[0] = mlrval.FromString(accumulator.Head.Key)
@@ -508,7 +504,7 @@ func foldMap(
 		argsArray[2] = mlrval.FromString(pe.Key)
 		argsArray[3] = pe.Value.Copy()
 		retval := (udfCallsite.EvaluateWithArguments(state, udfCallsite.udf, argsArray))
-		kvPair := getKVPairForCallbackOrDie(retval, "reduce")
+		kvPair := getKVPairForCallbackOrDie(retval, "fold")
 		accumulator = kvPair
 	}
 	return mlrval.FromMap(accumulator)
@@ -560,6 +556,22 @@ func SortHOF(
 	return nil
 }
 
+// SortByKey implements sort_by_key, which is sort on a map with no flags.
+func SortByKey(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	if !input1.IsMap() {
+		return mlrval.FromNotMapError("sort_by_key", input1)
+	}
+	return sortM(input1, "")
+}
+
+// SortByValue implements sort_by_value, which is sort on a map with "v" flag.
+func SortByValue(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+	if !input1.IsMap() {
+		return mlrval.FromNotMapError("sort_by_value", input1)
+	}
+	return sortM(input1, "v")
+}
+
 // ----------------------------------------------------------------
 // Helpers for sort with string flags in place of callback UDF.
 
@@ -227,7 +227,7 @@ but you can emit any map-valued expression.
   Example: mlr --from f.dat put 'emit1 $*'
   Example: mlr --from f.dat put 'emit1 mapsum({"id": NR}, $*)'
 
-Please see %s://johnkerl.org/miller/doc for more information.
+Please see %s/en/latest/reference-dsl-output-statements/ for more information.
 `, lib.DOC_URL)
 }
 
@@ -261,7 +261,7 @@ etc., to control the format of the output if the output is redirected. See also
   Example: mlr --from f.dat put '@sums[$a][$b]+=$x; emit > stderr, @*, "index1", "index2"'
   Example: mlr --from f.dat put '@sums[$a][$b]+=$x; emit | "grep somepattern", @*, "index1", "index2"'
 
-Please see %s://johnkerl.org/miller/doc for more information.
+Please see %s/en/latest/reference-dsl-output-statements/ for more information.
 `, lib.DOC_URL)
 }
 
@@ -292,7 +292,7 @@ etc., to control the format of the output if the output is redirected. See also
   Example: mlr --from f.dat put '@a=$i;@b+=$x;@c+=$y; emitf | "grep somepattern", @a, @b, @c'
   Example: mlr --from f.dat put '@a=$i;@b+=$x;@c+=$y; emitf | "grep somepattern > mytap.dat", @a, @b, @c'
 
-Please see %s://johnkerl.org/miller/doc for more information.
+Please see %s/en/latest/reference-dsl-output-statements/ for more information.
 `, lib.DOC_URL)
 }
 
@@ -325,7 +325,7 @@ etc., to control the format of the output if the output is redirected. See also
   Example: mlr --from f.dat put '@sums[$a][$b]+=$x; emitp > stderr, @*, "index1", "index2"'
   Example: mlr --from f.dat put '@sums[$a][$b]+=$x; emitp | "grep somepattern", @*, "index1", "index2"'
 
-Please see %s://johnkerl.org/miller/doc for more information.
+Please see %s/en/latest/reference-dsl-output-statements/ for more information.
 `, lib.DOC_URL)
 }
 
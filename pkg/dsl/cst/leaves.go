@@ -108,8 +108,20 @@ func (node *DirectFieldRvalueNode) Evaluate(
 	if value == nil {
 		return mlrval.ABSENT.StrictModeCheck(state.StrictMode, "$"+node.fieldName)
 	} else {
+		return fieldValueForRead(state, value)
+	}
+}
+
+// fieldValueForRead is for put/filter -S, where field values are read as
+// strings without type inference. The record itself is not modified, so
+// fields which aren't assigned to are written out as they came in.
+func fieldValueForRead(state *runtime.State, value *mlrval.Mlrval) *mlrval.Mlrval {
+	if !state.StringFieldValues {
 		return value
 	}
+	stringValue := value.Copy()
+	stringValue.StringifyOriginalValuesRecursively()
+	return stringValue
 }
 
 // ----------------------------------------------------------------
@@ -130,7 +142,11 @@ func (node *FullSrecRvalueNode) Evaluate(
 	if state.Inrec == nil {
 		return mlrval.ABSENT.StrictModeCheck(state.StrictMode, "$*")
 	} else {
-		return mlrval.FromMap(state.Inrec)
+		srec := mlrval.FromMap(state.Inrec)
+		if state.StringFieldValues {
+			srec.StringifyOriginalValuesRecursively()
+		}
+		return srec
 	}
 }
 
@@ -220,7 +220,11 @@ func (site *UDFCallsite) EvaluateWithArguments(
 		state.Stack.PushStackFrame()
 		defer state.Stack.PopStackFrame()
 	} else {
-		state.Stack.PushStackFrameSet()
+		err := state.Stack.PushStackFrameSet()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return mlrval.FromError(err)
+		}
 		defer state.Stack.PopStackFrameSet()
 	}
 	state.PushRegexCapturesFrame()
@@ -118,7 +118,10 @@ func (site *UDSCallsite) Execute(state *runtime.State) (*BlockExitPayload, error
 	}
 
 	// Bind the arguments to the parameters
-	state.Stack.PushStackFrameSet()
+	err := state.Stack.PushStackFrameSet()
+	if err != nil {
+		return nil, err
+	}
 	defer state.Stack.PopStackFrameSet()
 	state.PushRegexCapturesFrame()
 	defer state.PopRegexCapturesFrame()
@@ -42,8 +42,11 @@ type MultiIRSLineReader struct {
 	eof        bool
 }
 
+// NewLineReader returns a line reader for the given terminator. A leading
+// byte-order mark is stripped, so that it doesn't become part of the first
+// field name.
 func NewLineReader(handle io.Reader, irs string) ILineReader {
-	underlying := bufio.NewReader(handle)
+	underlying := bufio.NewReader(NewBOMStrippingReader(handle))
 
 	irs_len := len(irs)
 
@@ -5,6 +5,7 @@ package input
 
 import (
 	"container/list"
+	"fmt"
 
 	"github.com/johnkerl/miller/pkg/types"
 )
@@ -24,3 +25,39 @@ type IRecordReader interface {
 		downstreamDoneChannel <-chan bool, // for mlr head
 	)
 }
+
+// ReaderError is a data error found by a record-reader, along with where in
+// the input it was found, so that the user sees e.g.
+//
+//	mlr: data.csv:42: CSV header/data length mismatch 5 != 6.
+//
+// Line and column numbers are 1-up; zero means not known.
+type ReaderError struct {
+	Filename string
+	Line     int64
+	Column   int64
+	Err      error
+}
+
+func NewReaderError(filename string, line int64, column int64, err error) *ReaderError {
+	return &ReaderError{
+		Filename: filename,
+		Line:     line,
+		Column:   column,
+		Err:      err,
+	}
+}
+
+func (e *ReaderError) Error() string {
+	if e.Line <= 0 {
+		return fmt.Sprintf("%s: %v", e.Filename, e.Err)
+	} else if e.Column <= 0 {
+		return fmt.Sprintf("%s:%d: %v", e.Filename, e.Line, e.Err)
+	} else {
+		return fmt.Sprintf("%s:%d:%d: %v", e.Filename, e.Line, e.Column, e.Err)
+	}
+}
+
+func (e *ReaderError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,218 @@
+// This is the record-reader for --auto-format, which looks at the start of
+// each input file to decide its format, then hands the file off to the
+// record-reader for that format.
+
+package input
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"io"
+	"strings"
+
+	"github.com/johnkerl/miller/pkg/cli"
+	"github.com/johnkerl/miller/pkg/lib"
+	"github.com/johnkerl/miller/pkg/types"
+)
+
+// handleProcessor is the processHandle method of the record-reader for a
+// detected format.
+type handleProcessor func(
+	handle io.Reader,
+	filename string,
+	context *types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	errorChannel chan error,
+	downstreamDoneChannel <-chan bool, // for mlr head
+)
+
+type RecordReaderAuto struct {
+	readerOptions   *cli.TReaderOptions
+	recordsPerBatch int64 // distinct from readerOptions.RecordsPerBatch for join/repl
+
+	// Created on first use, since e.g. the CSV reader may reject the user's
+	// separators even though no input is CSV.
+	processorsByFormat map[string]handleProcessor
+}
+
+func NewRecordReaderAuto(
+	readerOptions *cli.TReaderOptions,
+	recordsPerBatch int64,
+) (*RecordReaderAuto, error) {
+	return &RecordReaderAuto{
+		readerOptions:      readerOptions,
+		recordsPerBatch:    recordsPerBatch,
+		processorsByFormat: make(map[string]handleProcessor),
+	}, nil
+}
+
+func (reader *RecordReaderAuto) Read(
+	filenames []string,
+	context types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	errorChannel chan error,
+	downstreamDoneChannel <-chan bool, // for mlr head
+) {
+	if filenames != nil { // nil for mlr -n
+		if len(filenames) == 0 { // read from stdin
+			handle, err := lib.OpenStdin(
+				reader.readerOptions.Prepipe,
+				reader.readerOptions.PrepipeIsRaw,
+				reader.readerOptions.FileInputEncoding,
+			)
+			if err != nil {
+				errorChannel <- err
+			} else {
+				reader.processHandle(handle, "(stdin)", &context, readerChannel, errorChannel, downstreamDoneChannel)
+			}
+		} else {
+			for _, filename := range filenames {
+				handle, err := lib.OpenFileForRead(
+					filename,
+					reader.readerOptions.Prepipe,
+					reader.readerOptions.PrepipeIsRaw,
+					reader.readerOptions.FileInputEncoding,
+				)
+				if err != nil {
+					errorChannel <- err
+				} else {
+					reader.processHandle(handle, filename, &context, readerChannel, errorChannel, downstreamDoneChannel)
+					handle.Close()
+				}
+			}
+		}
+	}
+	readerChannel <- types.NewEndOfStreamMarkerList(&context)
+}
+
+func (reader *RecordReaderAuto) processHandle(
+	handle io.Reader,
+	filename string,
+	context *types.Context,
+	readerChannel chan<- *list.List, // list of *types.RecordAndContext
+	errorChannel chan error,
+	downstreamDoneChannel <-chan bool, // for mlr head
+) {
+	// Read only as far as the first line which decides the format, so this
+	// works with tail -f. Then give the format's reader everything, including
+	// what was read here.
+	bufferedHandle := bufio.NewReader(handle)
+	var consumed bytes.Buffer
+	format := ""
+	for format == "" {
+		line, err := bufferedHandle.ReadString('\n')
+		consumed.WriteString(line)
+		if consumed.Len() == len(line) {
+			line = strings.TrimPrefix(line, CSV_BOM)
+		}
+		format = reader.detectFormat(line)
+		if err != nil {
+			break
+		}
+	}
+	if format == "" {
+		// Empty or all comments
+		format = "dkvp"
+	}
+
+	processor, err := reader.getProcessor(format)
+	if err != nil {
+		errorChannel <- err
+		return
+	}
+	processor(
+		io.MultiReader(&consumed, bufferedHandle),
+		filename,
+		context,
+		readerChannel,
+		errorChannel,
+		downstreamDoneChannel,
+	)
+}
+
+// detectFormat returns the input format indicated by the line, or "" if the
+// line is empty or a comment and so the next line needs to be looked at.
+func (reader *RecordReaderAuto) detectFormat(line string) string {
+	line = strings.TrimRight(line, "\r\n")
+	if reader.readerOptions.CommentHandling != cli.CommentsAreData {
+		if strings.HasPrefix(line, reader.readerOptions.CommentString) {
+			return ""
+		}
+	}
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" {
+		return ""
+	}
+	return detectFormatFromLine(trimmed)
+}
+
+// detectFormatFromLine returns "json", "csv", or "dkvp" for the first data
+// line of a file, as described in the help for --auto-format.
+func detectFormatFromLine(line string) string {
+	if strings.HasPrefix(line, "{") || strings.HasPrefix(line, "[") {
+		return "json"
+	}
+
+	fields := strings.Split(line, ",")
+	allPairs := true
+	plausibleHeader := len(fields) >= 2
+	for _, field := range fields {
+		hasEquals := strings.Contains(field, "=")
+		if !hasEquals {
+			allPairs = false
+		}
+		if hasEquals || strings.TrimSpace(field) == "" {
+			plausibleHeader = false
+		}
+	}
+
+	if allPairs {
+		return "dkvp"
+	}
+	if plausibleHeader {
+		return "csv"
+	}
+	return "dkvp"
+}
+
+func (reader *RecordReaderAuto) getProcessor(format string) (handleProcessor, error) {
+	processor, ok := reader.processorsByFormat[format]
+	if ok {
+		return processor, nil
+	}
+
+	formatOptions := cli.ReaderOptionsForDetectedFormat(reader.readerOptions, format)
+	switch format {
+	case "json":
+		jsonReader, err := NewRecordReaderJSON(formatOptions, reader.recordsPerBatch)
+		if err != nil {
+			return nil, err
+		}
+		processor = jsonReader.processHandle
+	case "csv":
+		csvReader, err := NewRecordReaderCSV(formatOptions, reader.recordsPerBatch)
+		if err != nil {
+			return nil, err
+		}
+		processor = csvReader.processHandle
+	default:
+		dkvpReader, err := NewRecordReaderDKVP(formatOptions, reader.recordsPerBatch)
+		if err != nil {
+			return nil, err
+		}
+		processor = func(
+			handle io.Reader,
+			filename string,
+			context *types.Context,
+			readerChannel chan<- *list.List,
+			errorChannel chan error,
+			downstreamDoneChannel <-chan bool,
+		) {
+			dkvpReader.processHandle(handle, filename, context, readerChannel, errorChannel, downstreamDoneChannel)
+		}
+	}
+
+	reader.processorsByFormat[format] = processor
+	return processor, nil
+}
@@ -0,0 +1,21 @@
+package input
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectFormatFromLine(t *testing.T) {
+	assert.Equal(t, "json", detectFormatFromLine(`{"a": 1}`))
+	assert.Equal(t, "json", detectFormatFromLine(`[`))
+	assert.Equal(t, "dkvp", detectFormatFromLine("a=1,b=2"))
+	assert.Equal(t, "dkvp", detectFormatFromLine("a=1"))
+	assert.Equal(t, "csv", detectFormatFromLine("a,b,c"))
+	assert.Equal(t, "csv", detectFormatFromLine("a b,c"))
+
+	// Neither all pairs nor a plausible header
+	assert.Equal(t, "dkvp", detectFormatFromLine("a=1,b"))
+	assert.Equal(t, "dkvp", detectFormatFromLine("a,,c"))
+	assert.Equal(t, "dkvp", detectFormatFromLine("hello world"))
+}
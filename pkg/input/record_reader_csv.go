@@ -3,6 +3,7 @@ package input
 import (
 	"bytes"
 	"container/list"
+	"errors"
 	"fmt"
 	"io"
 	"strconv"
@@ -25,11 +26,19 @@ type RecordReaderCSV struct {
 	csvTrimLeadingSpace bool  // Maps directly to Go's CSV library's TrimLeadingSpace
 
 	filename   string
-	rowNumber  int64
 	needHeader bool
 	header     []string
 }
 
+// csvRecordAndLine is a CSV record along with the input line number it
+// started on, for error messages. This needs to be captured by the scanner
+// goroutine right after each record is read, since the CSV library only keeps
+// positions for the most recent record.
+type csvRecordAndLine struct {
+	fields []string
+	line   int64
+}
+
 func NewRecordReaderCSV(
 	readerOptions *cli.TReaderOptions,
 	recordsPerBatch int64,
@@ -101,7 +110,6 @@ func (reader *RecordReaderCSV) processHandle(
 
 	// Reset state for start of next input file
 	reader.filename = filename
-	reader.rowNumber = 0
 	reader.needHeader = !reader.readerOptions.UseImplicitHeader
 	reader.header = nil
 
@@ -110,8 +118,8 @@ func (reader *RecordReaderCSV) processHandle(
 	csvReader.LazyQuotes = reader.csvLazyQuotes
 	csvReader.TrimLeadingSpace = reader.csvTrimLeadingSpace
 	csvRecordsChannel := make(chan *list.List, recordsPerBatch)
-	go channelizedCSVRecordScanner(csvReader, csvRecordsChannel, downstreamDoneChannel, errorChannel,
-		recordsPerBatch)
+	go channelizedCSVRecordScanner(csvReader, filename, csvRecordsChannel, downstreamDoneChannel,
+		errorChannel, recordsPerBatch)
 
 	for {
 		recordsAndContexts, eof := reader.getRecordBatch(csvRecordsChannel, errorChannel, context)
@@ -127,6 +135,7 @@ func (reader *RecordReaderCSV) processHandle(
 // TODO: comment
 func channelizedCSVRecordScanner(
 	csvReader *csv.Reader,
+	filename string,
 	csvRecordsChannel chan<- *list.List,
 	downstreamDoneChannel <-chan bool, // for mlr head
 	errorChannel chan error,
@@ -144,14 +153,22 @@ func channelizedCSVRecordScanner(
 		if lib.IsEOF(err) {
 			break
 		}
-		if err != nil && csvRecord == nil {
+		if err != nil && !errors.Is(err, csv.ErrFieldCount) {
 			// See https://golang.org/pkg/encoding/csv.
 			// We handle field-count ourselves.
-			errorChannel <- err
+			var parseError *csv.ParseError
+			if errors.As(err, &parseError) {
+				errorChannel <- NewReaderError(
+					filename, int64(parseError.Line), int64(parseError.Column), parseError.Err,
+				)
+			} else {
+				errorChannel <- NewReaderError(filename, 0, 0, err)
+			}
 			break
 		}
 
-		csvRecords.PushBack(csvRecord)
+		line, _ := csvReader.FieldPos(0)
+		csvRecords.PushBack(&csvRecordAndLine{fields: csvRecord, line: int64(line)})
 
 		// See if downstream processors will be ignoring further data (e.g. mlr
 		// head).  If so, stop reading. This makes 'mlr head hugefile' exit
@@ -197,7 +214,8 @@ func (reader *RecordReaderCSV) getRecordBatch(
 	}
 
 	for e := csvRecords.Front(); e != nil; e = e.Next() {
-		csvRecord := e.Value.([]string)
+		csvRecordAndLine := e.Value.(*csvRecordAndLine)
+		csvRecord := csvRecordAndLine.fields
 
 		if reader.needHeader {
 			isData := reader.maybeConsumeComment(csvRecord, context, recordsAndContexts)
@@ -206,7 +224,6 @@ func (reader *RecordReaderCSV) getRecordBatch(
 			}
 
 			reader.header = csvRecord
-			reader.rowNumber++
 			reader.needHeader = false
 			continue
 		}
@@ -215,7 +232,6 @@ func (reader *RecordReaderCSV) getRecordBatch(
 		if !isData {
 			continue
 		}
-		reader.rowNumber++
 
 		if reader.header == nil { // implicit CSV header
 			n := len(csvRecord)
@@ -243,12 +259,8 @@ func (reader *RecordReaderCSV) getRecordBatch(
 
 		} else {
 			if !reader.readerOptions.AllowRaggedCSVInput {
-				err := fmt.Errorf(
-					"mlr: CSV header/data length mismatch %d != %d "+
-						"at filename %s row %d.\n",
-					nh, nd, reader.filename, reader.rowNumber,
-				)
-				errorChannel <- err
+				err := fmt.Errorf("CSV header/data length mismatch %d != %d", nh, nd)
+				errorChannel <- NewReaderError(reader.filename, csvRecordAndLine.line, 0, err)
 				return
 			}
 
@@ -274,8 +286,18 @@ func (reader *RecordReaderCSV) getRecordBatch(
 						return
 					}
 				}
+			} else {
+				// if header longer than data: fill with empty, as do the
+				// CSV-lite and TSV readers
+				for i = nd; i < nh; i++ {
+					key := reader.header[i]
+					_, err := record.PutReferenceMaybeDedupe(key, mlrval.VOID.Copy(), dedupeFieldNames)
+					if err != nil {
+						errorChannel <- err
+						return
+					}
+				}
 			}
-			// if nh > nd: leave it short. This is a job for unsparsify.
 		}
 
 		context.UpdateForInputRecord()
@@ -358,8 +380,8 @@ func (wb WorkaroundBuffer) String() string {
 // ----------------------------------------------------------------
 // BOM-stripping
 //
-// Some CSVs start with a "byte-order mark" which is the 3-byte sequene
-// \xef\xbb\xbf".  Any file with such contents trips up csv.Reader:
+// Some CSVs start with a "byte-order mark" which is the 3-byte sequence
+// "\xef\xbb\xbf".  Any file with such contents trips up csv.Reader:
 //
 // * If a header line is not double-quoted then we can simply look at the first
 //   record returned by csv.Reader and strip away the first three bytes if they
@@ -370,10 +392,12 @@ func (wb WorkaroundBuffer) String() string {
 //   to be *inside* the double quotes).
 //
 // So we must wrap the io.Reader which is passed to csv.Reader. This
-// BOMStrippingReader class does precisely that.
+// BOMStrippingReader class does precisely that. The line readers and the JSON
+// decoder used by the other record-readers are wrapped the same way, since
+// files exported from spreadsheets may have a BOM regardless of format.
 
 // BOMStrippingReader implements io.Reader to strip leading byte-order-mark
-// characters off of CSV data.
+// characters off of input data.
 type BOMStrippingReader struct {
 	underlying io.Reader
 	pastBOM    bool
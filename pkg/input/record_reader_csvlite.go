@@ -182,13 +182,6 @@ func getRecordBatchExplicitCSVHeader(
 
 		reader.inputLineNumber++
 
-		// Strip CSV BOM
-		if reader.inputLineNumber == 1 {
-			if strings.HasPrefix(line, CSV_BOM) {
-				line = strings.Replace(line, CSV_BOM, "", 1)
-			}
-		}
-
 		// Check for comments-in-data feature
 		// TODO: function-pointer this away
 		if reader.readerOptions.CommentHandling != cli.CommentsAreData {
@@ -217,11 +210,10 @@ func getRecordBatchExplicitCSVHeader(
 		} else {
 			if !reader.readerOptions.AllowRaggedCSVInput && len(reader.headerStrings) != len(fields) {
 				err := fmt.Errorf(
-					"mlr: CSV header/data length mismatch %d != %d "+
-						"at filename %s line  %d.\n",
-					len(reader.headerStrings), len(fields), filename, reader.inputLineNumber,
+					"CSV header/data length mismatch %d != %d",
+					len(reader.headerStrings), len(fields),
 				)
-				errorChannel <- err
+				errorChannel <- NewReaderError(filename, reader.inputLineNumber, 0, err)
 				return
 			}
 
@@ -342,11 +334,10 @@ func getRecordBatchImplicitCSVHeader(
 		} else {
 			if !reader.readerOptions.AllowRaggedCSVInput && len(reader.headerStrings) != len(fields) {
 				err := fmt.Errorf(
-					"mlr: CSV header/data length mismatch %d != %d "+
-						"at filename %s line  %d.\n",
-					len(reader.headerStrings), len(fields), filename, reader.inputLineNumber,
+					"CSV header/data length mismatch %d != %d",
+					len(reader.headerStrings), len(fields),
 				)
-				errorChannel <- err
+				errorChannel <- NewReaderError(filename, reader.inputLineNumber, 0, err)
 				return
 			}
 		}
@@ -200,6 +200,13 @@ func recordFromNIDXLine(reader *RecordReaderDKVPNIDX, line string) (*mlrval.Mlrm
 	record := mlrval.NewMlrmapAsRecord()
 
 	values := reader.fieldSplitter.Split(line)
+	if reader.readerOptions.IFSRegex != nil && reader.readerOptions.AllowRepeatIFS {
+		// As with --repifs, leading and trailing separators don't make empty
+		// fields: e.g. '  a b' is fields 'a' and 'b' in the default whitespace
+		// splitting for NIDX, as it would be for awk. A user-specified
+		// --ifs-regex without --repifs keeps them, as --ifs does.
+		values = trimEmptyEnds(values)
+	}
 
 	var i int = 0
 	for _, value := range values {
@@ -210,3 +217,15 @@ func recordFromNIDXLine(reader *RecordReaderDKVPNIDX, line string) (*mlrval.Mlrm
 	}
 	return record, nil
 }
+
+// trimEmptyEnds removes empty strings from the start and end of the slice,
+// keeping any in the interior.
+func trimEmptyEnds(values []string) []string {
+	for len(values) > 0 && values[0] == "" {
+		values = values[1:]
+	}
+	for len(values) > 0 && values[len(values)-1] == "" {
+		values = values[:len(values)-1]
+	}
+	return values
+}
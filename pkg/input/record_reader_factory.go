@@ -30,6 +30,8 @@ func Create(readerOptions *cli.TReaderOptions, recordsPerBatch int64) (IRecordRe
 		return NewRecordReaderXTAB(readerOptions, recordsPerBatch)
 	case "gen":
 		return NewPseudoReaderGen(readerOptions, recordsPerBatch)
+	case cli.AUTO_INPUT_FORMAT:
+		return NewRecordReaderAuto(readerOptions, recordsPerBatch)
 	default:
 		return nil, fmt.Errorf("input file format \"%s\" not found", readerOptions.InputFileFormat)
 	}
@@ -2,8 +2,10 @@ package input
 
 import (
 	"container/list"
+	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	"encoding/json"
@@ -83,101 +85,139 @@ func (reader *RecordReaderJSON) processHandle(
 	// TODO: comment
 	recordsPerBatch := reader.recordsPerBatch
 
+	handle = NewBOMStrippingReader(handle)
 	if reader.readerOptions.CommentHandling != cli.CommentsAreData {
 		handle = NewJSONCommentEnabledReader(handle, reader.readerOptions, readerChannel)
 	}
-	decoder := json.NewDecoder(handle)
+	lineTracker := newJSONLineTracker(handle)
+	decoder := json.NewDecoder(lineTracker)
+	decoder.UseNumber()
 	recordsAndContexts := list.New()
 
+	// Attaches the input position to an error found while decoding. Syntax
+	// errors know where they happened; otherwise we use how far the decoder
+	// has gotten, which is the end of the offending value.
+	positionedError := func(err error) error {
+		var syntaxError *json.SyntaxError
+		if errors.As(err, &syntaxError) {
+			line, column := lineTracker.position(syntaxError.Offset - 1)
+			return NewReaderError(filename, line, column, err)
+		}
+		line, _ := lineTracker.position(decoder.InputOffset())
+		return NewReaderError(filename, line, 0, err)
+	}
+
+	// Delivers a record to the output batch. Returns false if the value isn't
+	// a record, with the error already sent.
+	deliver := func(mlrval *mlrval.Mlrval) bool {
+		if !mlrval.IsMap() {
+			errorChannel <- positionedError(fmt.Errorf(
+				"valid but unmillerable JSON. Expected map (JSON object); got %s",
+				mlrval.GetTypeName(),
+			))
+			return false
+		}
+		record := mlrval.GetMap()
+		if record == nil {
+			errorChannel <- fmt.Errorf("internal coding error detected in JSON record-reader")
+			return false
+		}
+		context.UpdateForInputRecord()
+		recordsAndContexts.PushBack(types.NewRecordAndContext(record, context))
+		lineTracker.forget(decoder.InputOffset())
+
+		if int64(recordsAndContexts.Len()) >= recordsPerBatch {
+			readerChannel <- recordsAndContexts
+			recordsAndContexts = list.New()
+		}
+		return true
+	}
+
 	eof := false
 	i := int64(0)
-	for {
-		// See if downstream processors will be ignoring further data (e.g. mlr
-		// head).  If so, stop reading. This makes 'mlr head hugefile' exit
-		// quickly, as it should. Do this channel-check every so often to avoid
-		// scheduler overhead.
+	// See if downstream processors will be ignoring further data (e.g. mlr
+	// head).  If so, stop reading. This makes 'mlr head hugefile' exit
+	// quickly, as it should. Do this channel-check every so often to avoid
+	// scheduler overhead.
+	downstreamDone := func() bool {
 		i++
 		if i%recordsPerBatch == 0 {
 			select {
 			case _ = <-downstreamDoneChannel:
 				eof = true
-				break
 			default:
-				break
-			}
-			if eof {
-				break
 			}
 		}
+		return eof
+	}
 
-		mlrval, eof, err := mlrval.MlrvalDecodeFromJSON(decoder)
-		if eof {
+	for !downstreamDone() {
+		startToken, err := decoder.Token()
+		if err == io.EOF {
 			break
 		}
 		if err != nil {
-			errorChannel <- err
+			errorChannel <- positionedError(err)
 			return
 		}
 
 		// Find out what we got.
 		// * Map is an input record: deliver it.
-		// * Array is OK if it's array of input record: deliver them.
+		// * Array is OK if it's array of input records: deliver them. These
+		//   are decoded one at a time, so a large top-level array is streamed
+		//   rather than held in memory all at once.
 		// * Non-collection types are valid but unmillerable JSON.
 
-		if mlrval.IsMap() {
-			// TODO: make a helper method
-			record := mlrval.GetMap()
-			if record == nil {
-				errorChannel <- fmt.Errorf("internal coding error detected in JSON record-reader")
-				return
-			}
-			context.UpdateForInputRecord()
-			recordsAndContexts.PushBack(types.NewRecordAndContext(record, context))
-
-			if int64(recordsAndContexts.Len()) >= recordsPerBatch {
-				readerChannel <- recordsAndContexts
-				recordsAndContexts = list.New()
-			}
-
-		} else if mlrval.IsArray() {
-
+		if delimiter, isDelim := startToken.(json.Delim); isDelim && delimiter == '[' {
 			reader.sawBrackets = true
 
-			records := mlrval.GetArray()
-			if records == nil {
-				errorChannel <- fmt.Errorf("internal coding error detected in JSON record-reader")
-				return
-			}
-
-			for _, mlrval := range records {
-				if !mlrval.IsMap() {
-					// TODO: more context
-					errorChannel <- fmt.Errorf(
-						"valid but unmillerable JSON. Expected map (JSON object); got %s.",
-						mlrval.GetTypeName(),
-					)
+			for decoder.More() {
+				if downstreamDone() {
+					break
+				}
+				mlrval, eof, err := mlrval.MlrvalDecodeFromJSON(decoder)
+				if eof {
+					errorChannel <- positionedError(fmt.Errorf("JSON parser: unexpected premature EOF"))
 					return
 				}
-				record := mlrval.GetMap()
-				if record == nil {
-					errorChannel <- fmt.Errorf("internal coding error detected in JSON record-reader")
+				if err != nil {
+					errorChannel <- positionedError(err)
 					return
 				}
-				context.UpdateForInputRecord()
-				recordsAndContexts.PushBack(types.NewRecordAndContext(record, context))
-
-				if int64(recordsAndContexts.Len()) >= recordsPerBatch {
-					readerChannel <- recordsAndContexts
-					recordsAndContexts = list.New()
+				if !deliver(mlrval) {
+					return
 				}
 			}
+			if eof {
+				break
+			}
+
+			endToken, err := decoder.Token()
+			if err == io.EOF {
+				errorChannel <- positionedError(fmt.Errorf("JSON parser: unexpected premature EOF"))
+				return
+			}
+			if err != nil {
+				errorChannel <- positionedError(err)
+				return
+			}
+			if endDelimiter, ok := endToken.(json.Delim); !ok || endDelimiter != ']' {
+				errorChannel <- positionedError(fmt.Errorf("JSON reader: did not find closing token \"]\" for JSON array"))
+				return
+			}
 
 		} else {
-			errorChannel <- fmt.Errorf(
-				"valid but unmillerable JSON. Expected map (JSON object); got %s.",
-				mlrval.GetTypeName(),
-			)
-			return
+			mlrval, eof, err := mlrval.MlrvalDecodeFromJSONStartToken(decoder, startToken)
+			if eof {
+				break
+			}
+			if err != nil {
+				errorChannel <- positionedError(err)
+				return
+			}
+			if !deliver(mlrval) {
+				return
+			}
 		}
 	}
 
@@ -186,6 +226,64 @@ func (reader *RecordReaderJSON) processHandle(
 	}
 }
 
+// ----------------------------------------------------------------
+// jsonLineTracker wraps the JSON decoder's input, remembering where the
+// newlines are so that a byte offset from the decoder can be reported as a
+// line and column. Newline positions before the start of the current record
+// are forgotten as records are delivered, so memory use is bounded by the
+// decoder's read-ahead rather than by the size of the input.
+
+type jsonLineTracker struct {
+	underlying           io.Reader
+	offset               int64   // number of bytes read so far
+	newlineOffsets       []int64 // positions of newlines not yet forgotten
+	numForgotten         int64   // number of newlines forgotten
+	lastForgottenNewline int64   // position of the last forgotten newline, or -1
+}
+
+func newJSONLineTracker(underlying io.Reader) *jsonLineTracker {
+	return &jsonLineTracker{
+		underlying:           underlying,
+		newlineOffsets:       make([]int64, 0),
+		lastForgottenNewline: -1,
+	}
+}
+
+func (tracker *jsonLineTracker) Read(p []byte) (n int, err error) {
+	n, err = tracker.underlying.Read(p)
+	for i := 0; i < n; i++ {
+		if p[i] == '\n' {
+			tracker.newlineOffsets = append(tracker.newlineOffsets, tracker.offset+int64(i))
+		}
+	}
+	tracker.offset += int64(n)
+	return n, err
+}
+
+// position returns the 1-up line and column of the byte at the given offset.
+func (tracker *jsonLineTracker) position(offset int64) (line int64, column int64) {
+	i := sort.Search(len(tracker.newlineOffsets), func(k int) bool {
+		return tracker.newlineOffsets[k] >= offset
+	})
+	previousNewline := tracker.lastForgottenNewline
+	if i > 0 {
+		previousNewline = tracker.newlineOffsets[i-1]
+	}
+	return tracker.numForgotten + int64(i) + 1, offset - previousNewline
+}
+
+// forget discards the positions of newlines before the given offset.
+func (tracker *jsonLineTracker) forget(offset int64) {
+	i := sort.Search(len(tracker.newlineOffsets), func(k int) bool {
+		return tracker.newlineOffsets[k] >= offset
+	})
+	if i > 0 {
+		tracker.lastForgottenNewline = tracker.newlineOffsets[i-1]
+		tracker.numForgotten += int64(i)
+		tracker.newlineOffsets = tracker.newlineOffsets[i:]
+	}
+}
+
 // ================================================================
 // JSON comment-stripping
 //
@@ -240,36 +338,30 @@ func (bsr *JSONCommentEnabledReader) Read(p []byte) (n int, err error) {
 		return bsr.populateFromLine(p), nil
 	}
 
-	done := false
-
-	// Loop until we can get a non-comment line to pass on, or end of file.
-	for !done {
-		// EOF
-		line, err := bsr.lineReader.Read()
-		if err != nil {
-			return 0, err
-		}
-
-		// Non-comment line
-		if !strings.HasPrefix(line, bsr.readerOptions.CommentString) {
-			bsr.lineBytes = []byte(line)
-			return bsr.populateFromLine(p), nil
-		}
+	line, err := bsr.lineReader.Read()
+	if err != nil {
+		return 0, err
+	}
 
-		// Comment line
-		if bsr.readerOptions.CommentHandling == cli.PassComments {
-			// Insert the string into the record-output stream, so that goroutine can
-			// print it, resulting in deterministic output-ordering.
-			ell := list.New()
-			ell.PushBack(types.NewOutputString(line+"\n", bsr.context))
-			bsr.readerChannel <- ell
-		}
+	// Non-comment line. The newline is kept, and comment lines are replaced
+	// by empty lines, so that the JSON decoder's input offsets map to the
+	// right line numbers for error messages.
+	if !strings.HasPrefix(line, bsr.readerOptions.CommentString) {
+		bsr.lineBytes = []byte(line + "\n")
+		return bsr.populateFromLine(p), nil
+	}
 
-		if done {
-			break
-		}
+	// Comment line
+	if bsr.readerOptions.CommentHandling == cli.PassComments {
+		// Insert the string into the record-output stream, so that goroutine can
+		// print it, resulting in deterministic output-ordering.
+		ell := list.New()
+		ell.PushBack(types.NewOutputString(line+"\n", bsr.context))
+		bsr.readerChannel <- ell
 	}
-	return 0, nil
+
+	bsr.lineBytes = []byte("\n")
+	return bsr.populateFromLine(p), nil
 }
 
 // populateFromLine is a helper for Read. It takes a full line from the
@@ -0,0 +1,92 @@
+package input
+
+import (
+	"container/list"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnkerl/miller/pkg/cli"
+	"github.com/johnkerl/miller/pkg/types"
+)
+
+func TestJSONLineTracker(t *testing.T) {
+	tracker := newJSONLineTracker(strings.NewReader("ab\ncd\n\nef"))
+	_, err := io.ReadAll(tracker)
+	assert.Nil(t, err)
+
+	line, column := tracker.position(0)
+	assert.Equal(t, int64(1), line)
+	assert.Equal(t, int64(1), column)
+	line, column = tracker.position(4)
+	assert.Equal(t, int64(2), line)
+	assert.Equal(t, int64(2), column)
+	line, column = tracker.position(8)
+	assert.Equal(t, int64(4), line)
+	assert.Equal(t, int64(2), column)
+
+	// Positions after the forget-point are unaffected
+	tracker.forget(7)
+	line, column = tracker.position(8)
+	assert.Equal(t, int64(4), line)
+	assert.Equal(t, int64(2), column)
+	assert.Equal(t, 0, len(tracker.newlineOffsets))
+}
+
+func TestReaderErrorString(t *testing.T) {
+	err := errors.New("oops")
+	assert.Equal(t, "a.csv: oops", NewReaderError("a.csv", 0, 0, err).Error())
+	assert.Equal(t, "a.csv:42: oops", NewReaderError("a.csv", 42, 0, err).Error())
+	assert.Equal(t, "a.csv:42:7: oops", NewReaderError("a.csv", 42, 7, err).Error())
+	assert.True(t, errors.Is(NewReaderError("a.csv", 42, 7, err), err))
+}
+
+// readJSONForError runs the JSON reader over the input and returns the error
+// it reports, if any.
+func readJSONForError(t *testing.T, input string, readerOptions *cli.TReaderOptions) *ReaderError {
+	reader, err := NewRecordReaderJSON(readerOptions, 1)
+	assert.Nil(t, err)
+
+	context := types.NewContext()
+	readerChannel := make(chan *list.List, 100)
+	errorChannel := make(chan error, 1)
+	downstreamDoneChannel := make(chan bool, 1)
+	reader.processHandle(strings.NewReader(input), "test.json", context, readerChannel,
+		errorChannel, downstreamDoneChannel)
+
+	select {
+	case err := <-errorChannel:
+		var readerError *ReaderError
+		assert.True(t, errors.As(err, &readerError))
+		return readerError
+	default:
+		return nil
+	}
+}
+
+func TestJSONReaderErrorPositions(t *testing.T) {
+	readerOptions := cli.DefaultReaderOptions()
+
+	assert.Nil(t, readJSONForError(t, "{\"a\":1}\n{\"a\":2}\n", &readerOptions))
+
+	readerError := readJSONForError(t, "{\"a\":1}\n{\"a\":2,\n  \"b\":}\n", &readerOptions)
+	assert.NotNil(t, readerError)
+	assert.Equal(t, "test.json", readerError.Filename)
+	assert.Equal(t, int64(3), readerError.Line)
+	assert.Equal(t, int64(7), readerError.Column)
+
+	readerError = readJSONForError(t, "[\n{\"a\":1},\n\"abc\"\n]\n", &readerOptions)
+	assert.NotNil(t, readerError)
+	assert.Equal(t, int64(3), readerError.Line)
+
+	// Comment lines still count toward line numbers
+	readerOptions.CommentHandling = cli.SkipComments
+	readerOptions.CommentString = "#"
+	readerError = readJSONForError(t, "# x\n{\"a\":1}\n# y\n{\"a\": tru}\n", &readerOptions)
+	assert.NotNil(t, readerError)
+	assert.Equal(t, int64(4), readerError.Line)
+	assert.Equal(t, int64(10), readerError.Column)
+}
@@ -2,6 +2,7 @@ package input
 
 import (
 	"regexp"
+	"strings"
 
 	"github.com/johnkerl/miller/pkg/cli"
 )
@@ -18,7 +19,7 @@ func NewRecordReaderMarkdown(
 		readerOptions:    readerOptions,
 		recordsPerBatch:  recordsPerBatch,
 		separatorMatcher: regexp.MustCompile(`^\|[-\| ]+\|$`),
-		fieldSplitter:    newFieldSplitter(readerOptions),
+		fieldSplitter:    &tMarkdownFieldSplitter{},
 	}
 	if reader.readerOptions.UseImplicitHeader {
 		reader.recordBatchGetter = getRecordBatchImplicitPprintHeader
@@ -28,3 +29,29 @@ func NewRecordReaderMarkdown(
 	return reader, nil
 
 }
+
+// tMarkdownFieldSplitter splits Markdown table rows on pipes, except for
+// pipes escaped as "\|" within keys and values, which the Markdown writer
+// produces. Those are unescaped in the output.
+type tMarkdownFieldSplitter struct {
+}
+
+func (s *tMarkdownFieldSplitter) Split(input string) []string {
+	fields := make([]string, 0)
+	var buffer strings.Builder
+	n := len(input)
+	for i := 0; i < n; i++ {
+		c := input[i]
+		if c == '\\' && i+1 < n && input[i+1] == '|' {
+			buffer.WriteByte('|')
+			i++
+		} else if c == '|' {
+			fields = append(fields, buffer.String())
+			buffer.Reset()
+		} else {
+			buffer.WriteByte(c)
+		}
+	}
+	fields = append(fields, buffer.String())
+	return fields
+}
@@ -240,11 +240,10 @@ func getRecordBatchExplicitPprintHeader(
 		} else {
 			if !reader.readerOptions.AllowRaggedCSVInput && len(reader.headerStrings) != len(fields) {
 				err := fmt.Errorf(
-					"mlr: PPRINT-barred header/data length mismatch %d != %d "+
-						"at filename %s line  %d.\n",
-					len(reader.headerStrings), len(fields), filename, reader.inputLineNumber,
+					"PPRINT-barred header/data length mismatch %d != %d",
+					len(reader.headerStrings), len(fields),
 				)
-				errorChannel <- err
+				errorChannel <- NewReaderError(filename, reader.inputLineNumber, 0, err)
 				return
 			}
 
@@ -378,11 +377,10 @@ func getRecordBatchImplicitPprintHeader(
 		} else {
 			if !reader.readerOptions.AllowRaggedCSVInput && len(reader.headerStrings) != len(fields) {
 				err := fmt.Errorf(
-					"mlr: CSV header/data length mismatch %d != %d "+
-						"at filename %s line  %d.\n",
-					len(reader.headerStrings), len(fields), filename, reader.inputLineNumber,
+					"PPRINT-barred header/data length mismatch %d != %d",
+					len(reader.headerStrings), len(fields),
 				)
-				errorChannel <- err
+				errorChannel <- NewReaderError(filename, reader.inputLineNumber, 0, err)
 				return
 			}
 		}
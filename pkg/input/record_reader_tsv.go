@@ -181,16 +181,19 @@ func getRecordBatchExplicitTSVHeader(
 		fields := reader.fieldSplitter.Split(line)
 
 		if reader.headerStrings == nil {
-			reader.headerStrings = fields
+			// Field names are escaped the same as field values
+			reader.headerStrings = make([]string, len(fields))
+			for i, field := range fields {
+				reader.headerStrings[i] = lib.TSVDecodeField(field)
+			}
 			// Get data lines on subsequent loop iterations
 		} else {
 			if !reader.readerOptions.AllowRaggedCSVInput && len(reader.headerStrings) != len(fields) {
 				err := fmt.Errorf(
-					"mlr: TSV header/data length mismatch %d != %d "+
-						"at filename %s line  %d.\n",
-					len(reader.headerStrings), len(fields), filename, reader.inputLineNumber,
+					"TSV header/data length mismatch %d != %d",
+					len(reader.headerStrings), len(fields),
 				)
-				errorChannel <- err
+				errorChannel <- NewReaderError(filename, reader.inputLineNumber, 0, err)
 				return
 			}
 
@@ -307,11 +310,10 @@ func getRecordBatchImplicitTSVHeader(
 		} else {
 			if !reader.readerOptions.AllowRaggedCSVInput && len(reader.headerStrings) != len(fields) {
 				err := fmt.Errorf(
-					"mlr: TSV header/data length mismatch %d != %d "+
-						"at filename %s line  %d.\n",
-					len(reader.headerStrings), len(fields), filename, reader.inputLineNumber,
+					"TSV header/data length mismatch %d != %d",
+					len(reader.headerStrings), len(fields),
 				)
-				errorChannel <- err
+				errorChannel <- NewReaderError(filename, reader.inputLineNumber, 0, err)
 				return
 			}
 		}
@@ -13,13 +13,16 @@
 // * An indication to use an in-process encoding reader (gzip or bzip2, etc).
 //
 // If a prepipe is specified, it is used; else if an encoding is specified, it
-// is used; otherwise the file suffix (.bz2, .gz, .z) is consulted; otherwise
-// the file is treated as text.
+// is used; otherwise the file suffix (.bz2, .gz, .z, .zst) is consulted;
+// otherwise the first few bytes of the input are checked for bzip2, gzip, or
+// zstd magic numbers, which is how compressed standard input is recognized;
+// otherwise the input is treated as text.
 // ================================================================
 
 package lib
 
 import (
+	"bufio"
 	"bytes"
 	"compress/bzip2"
 	"compress/gzip"
@@ -181,8 +184,83 @@ func openEncodedHandleForRead(
 		return NewZstdReadCloser(handle)
 	}
 
-	// Pass along os.Stdin or os.Open(filename)
-	return handle, nil
+	// Pass along os.Stdin or os.Open(filename), decompressing it if it starts
+	// with the magic number of a compression format.
+	return openMagicDetectedHandleForRead(handle)
+}
+
+// Magic numbers at the start of compressed data, for input whose filename
+// doesn't say how it's compressed, such as standard input. The bzip2 magic
+// number is "BZh" and a block-size digit, then the first block's "pi" magic,
+// so as to not mistake text which happens to start with "BZh" for bzip2.
+var gzipMagic = []byte{0x1f, 0x8b}
+var zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+var bzip2Magic = []byte("BZh")
+var bzip2BlockMagic = []byte{0x31, 0x41, 0x59, 0x26, 0x53, 0x59}
+
+// findMagicEncoding returns the compression encoding indicated by the start
+// of the input, or FileInputEncodingDefault if there is none. Only as many
+// bytes are peeked as are needed to rule out each format, so that
+// line-at-a-time interactive input isn't held up.
+func findMagicEncoding(peeker interface{ Peek(int) ([]byte, error) }) TFileInputEncoding {
+	prefix, _ := peeker.Peek(len(gzipMagic))
+	if bytes.Equal(prefix, gzipMagic) {
+		return FileInputEncodingGzip
+	}
+	if len(prefix) < 2 {
+		return FileInputEncodingDefault
+	}
+
+	if prefix[0] == zstdMagic[0] && prefix[1] == zstdMagic[1] {
+		prefix, _ = peeker.Peek(len(zstdMagic))
+		if bytes.Equal(prefix, zstdMagic) {
+			return FileInputEncodingZstd
+		}
+	} else if prefix[0] == bzip2Magic[0] && prefix[1] == bzip2Magic[1] {
+		n := len(bzip2Magic) + 1 + len(bzip2BlockMagic)
+		prefix, _ = peeker.Peek(n)
+		if len(prefix) == n &&
+			bytes.Equal(prefix[:len(bzip2Magic)], bzip2Magic) &&
+			prefix[len(bzip2Magic)] >= '1' && prefix[len(bzip2Magic)] <= '9' &&
+			bytes.Equal(prefix[len(bzip2Magic)+1:], bzip2BlockMagic) {
+			return FileInputEncodingBzip2
+		}
+	}
+
+	return FileInputEncodingDefault
+}
+
+func openMagicDetectedHandleForRead(handle io.ReadCloser) (io.ReadCloser, error) {
+	bufferedHandle := &BufferedReadCloser{
+		originalHandle: handle,
+		bufferedHandle: bufio.NewReader(handle),
+	}
+
+	switch findMagicEncoding(bufferedHandle.bufferedHandle) {
+	case FileInputEncodingBzip2:
+		return NewBZip2ReadCloser(bufferedHandle), nil
+	case FileInputEncodingGzip:
+		return gzip.NewReader(bufferedHandle)
+	case FileInputEncodingZstd:
+		return NewZstdReadCloser(bufferedHandle)
+	}
+	return bufferedHandle, nil
+}
+
+// ----------------------------------------------------------------
+// BufferedReadCloser lets us peek at the start of the input for magic numbers
+// while still being able to close the original handle.
+type BufferedReadCloser struct {
+	originalHandle io.ReadCloser
+	bufferedHandle *bufio.Reader
+}
+
+func (rc *BufferedReadCloser) Read(p []byte) (n int, err error) {
+	return rc.bufferedHandle.Read(p)
+}
+
+func (rc *BufferedReadCloser) Close() error {
+	return rc.originalHandle.Close()
 }
 
 // ----------------------------------------------------------------
@@ -297,14 +375,26 @@ func FindInputEncoding(
 	if strings.HasSuffix(filename, ".z") {
 		return FileInputEncodingZlib
 	}
-	return FileInputEncodingDefault
+	if strings.HasSuffix(filename, ".zst") {
+		return FileInputEncodingZstd
+	}
+
+	// Compressed files without a telltale suffix are decompressed on read by
+	// magic number, so in-place mode needs to know to recompress them.
+	handle, err := os.Open(filename)
+	if err != nil {
+		return FileInputEncodingDefault
+	}
+	defer handle.Close()
+	return findMagicEncoding(bufio.NewReader(handle))
 }
 
 // WrapOutputHandle wraps a file-write handle with a decompressor.  The first
 // return value is the wrapped handle. The second is true if the returned
 // handle needs to be closed separately from the original.  The third is for
-// in-process compression we can't undo: namely, as of September 2021 the gzip
-// and zlib libraries support write-closers, but the bzip2 library does not.
+// in-process compression we can't undo: namely, as of September 2021 the gzip,
+// zlib, and zstd libraries support write-closers, but the bzip2 library does
+// not.
 func WrapOutputHandle(
 	fileWriteHandle io.WriteCloser,
 	inputFileEncoding TFileInputEncoding,
@@ -316,6 +406,12 @@ func WrapOutputHandle(
 		return gzip.NewWriter(fileWriteHandle), true, nil
 	case FileInputEncodingZlib:
 		return zlib.NewWriter(fileWriteHandle), true, nil
+	case FileInputEncodingZstd:
+		zstdWriter, err := zstd.NewWriter(fileWriteHandle)
+		if err != nil {
+			return fileWriteHandle, false, err
+		}
+		return zstdWriter, true, nil
 	default:
 		return fileWriteHandle, false, nil
 	}
@@ -0,0 +1,25 @@
+package lib
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func findMagicEncodingOfString(input string) TFileInputEncoding {
+	return findMagicEncoding(bufio.NewReader(strings.NewReader(input)))
+}
+
+func TestFindMagicEncoding(t *testing.T) {
+	assert.Equal(t, FileInputEncodingDefault, findMagicEncodingOfString(""))
+	assert.Equal(t, FileInputEncodingDefault, findMagicEncodingOfString("a"))
+	assert.Equal(t, FileInputEncodingDefault, findMagicEncodingOfString("a=1,b=2\n"))
+	assert.Equal(t, FileInputEncodingGzip, findMagicEncodingOfString("\x1f\x8b\x08\x00"))
+	assert.Equal(t, FileInputEncodingZstd, findMagicEncodingOfString("\x28\xb5\x2f\xfd\x04"))
+	assert.Equal(t, FileInputEncodingDefault, findMagicEncodingOfString("\x28\xb5\x2f"))
+	assert.Equal(t, FileInputEncodingBzip2, findMagicEncodingOfString("BZh91AY&SY..."))
+	assert.Equal(t, FileInputEncodingDefault, findMagicEncodingOfString("BZh9 is not bzip2\n"))
+	assert.Equal(t, FileInputEncodingDefault, findMagicEncodingOfString("BZh"))
+}
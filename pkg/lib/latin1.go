@@ -36,3 +36,25 @@ func TryUTF8ToLatin1(input string) (string, error) {
 	output := buffer.String()
 	return output, nil
 }
+
+// UTF8ToLatin1WithReplacement is like TryUTF8ToLatin1 except that characters
+// not encodable as Latin-1, and invalid UTF-8 bytes, are written as the
+// replacement string rather than being an error. The replacement is written
+// as-is so it should itself be Latin-1.
+func UTF8ToLatin1WithReplacement(input string, replacement string) string {
+	var buffer bytes.Buffer
+
+	bytes := []byte(input)
+	for len(bytes) > 0 {
+		r, size := utf8.DecodeRune(bytes)
+
+		if r <= 0x00ff && !(r == utf8.RuneError && size == 1) {
+			buffer.WriteByte(byte(r))
+		} else {
+			buffer.WriteString(replacement)
+		}
+
+		bytes = bytes[size:]
+	}
+	return buffer.String()
+}
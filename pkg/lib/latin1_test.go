@@ -98,3 +98,11 @@ func TestUTF8ToLatin1(t *testing.T) {
 		}
 	}
 }
+
+func TestUTF8ToLatin1WithReplacement(t *testing.T) {
+	assert.Equal(t, "a\xe4o\xf6", UTF8ToLatin1WithReplacement("aäoö", "?"))
+	assert.Equal(t, "caf\xe9 ?? ok", UTF8ToLatin1WithReplacement("café 日本 ok", "?"))
+	assert.Equal(t, "x", UTF8ToLatin1WithReplacement("x€", ""))
+	// Invalid UTF-8 is replaced too
+	assert.Equal(t, "a?b", UTF8ToLatin1WithReplacement("a\xffb", "?"))
+}
@@ -25,6 +25,22 @@ func Sgn(a float64) float64 {
 	}
 }
 
+// FormatNonFiniteFloat returns "NaN", "+Inf", or "-Inf" for non-finite input,
+// with true; else it returns false. Float formatting and time conversion use
+// this so that such values come out the same way everywhere, rather than as
+// whatever a printf format or a time conversion makes of them.
+func FormatNonFiniteFloat(a float64) (string, bool) {
+	if math.IsNaN(a) {
+		return "NaN", true
+	} else if math.IsInf(a, 1) {
+		return "+Inf", true
+	} else if math.IsInf(a, -1) {
+		return "-Inf", true
+	} else {
+		return "", false
+	}
+}
+
 // Normal cumulative distribution function, expressed in terms of erfc library
 // function (which is awkward, but exists).
 func Qnorm(x float64) float64 {
@@ -50,6 +66,9 @@ func Qnorm(x float64) float64 {
 //   Here x_2 = x (the input) and x_1 = q(y_1).
 // * Solve for y_{n+1} and repeat.
 
+// The iteration stops once qnorm(y) is within INVQNORM_TOL of the input x.
+// This is an absolute error bound on the probability, not on y: for x very
+// near 0 or 1, where qnorm is flat, y can be off by much more than this.
 const INVQNORM_TOL float64 = 1e-9
 const INVQNORM_MAXITER int = 30
 
@@ -22,6 +22,13 @@ func SeedRandom(seed int64) {
 	generator = rand.New(source)
 }
 
+// GetRNG returns the generator shared by all of Miller's randomized verbs and
+// DSL functions, so that they are all reproducible with a single --seed.
+// Callers should not cache the return value, since SeedRandom replaces it.
+func GetRNG() *rand.Rand {
+	return generator
+}
+
 func RandFloat64() float64 {
 	return generator.Float64()
 }
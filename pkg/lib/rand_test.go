@@ -0,0 +1,32 @@
+package lib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeedRandom(t *testing.T) {
+	SeedRandom(1)
+	a := []int64{RandInt63(), RandRange(0, 10), int64(RandUint32())}
+	f := RandFloat64()
+	p := GetRNG().Perm(5)
+
+	SeedRandom(1)
+	b := []int64{RandInt63(), RandRange(0, 10), int64(RandUint32())}
+	assert.Equal(t, a, b)
+	assert.Equal(t, f, RandFloat64())
+	assert.Equal(t, p, GetRNG().Perm(5))
+
+	SeedRandom(2)
+	assert.NotEqual(t, a[0], RandInt63())
+}
+
+func TestRandRange(t *testing.T) {
+	SeedRandom(1)
+	assert.Equal(t, int64(7), RandRange(7, 7))
+	for i := 0; i < 100; i++ {
+		u := RandRange(3, 6)
+		assert.True(t, u >= 3 && u < 6)
+	}
+}
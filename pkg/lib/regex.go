@@ -126,13 +126,27 @@ func CompileMillerRegex(regexString string) (*regexp.Regexp, error) {
 	return regexpCompileCached(regexString)
 }
 
+// IsQuotedMillerRegex tells whether the string is of the form "a.*b" or
+// "a.*b"i. Verbs taking lists of field names use this to tell regexes apart
+// from literal names within the same list.
+func IsQuotedMillerRegex(s string) bool {
+	n := len(s)
+	if n >= 2 && s[0] == '"' && s[n-1] == '"' {
+		return true
+	}
+	if n >= 3 && s[0] == '"' && strings.HasSuffix(s, "\"i") {
+		return true
+	}
+	return false
+}
+
 // CompileMillerRegexOrDie wraps CompileMillerRegex. Usually in Go we want to
 // return a second error argument rather than fataling. However, if there's a
 // malformed regex we really cannot continue so it's simpler to just fatal.
 func CompileMillerRegexOrDie(regexString string) *regexp.Regexp {
 	regex, err := CompileMillerRegex(regexString)
 	if err != nil {
-		fmt.Fprint(os.Stderr, err)
+		fmt.Fprintf(os.Stderr, "mlr: could not compile regex \"%s\": %v\n", regexString, err)
 		os.Exit(1)
 	}
 	return regex
@@ -193,6 +207,17 @@ func RegexStringGsub(
 	return regexCompiledSubOrGsub(input, regex, replacement, replacementCaptureMatrix, false)
 }
 
+// RegexCompiledGsub is the same as RegexStringGsub but with compiled regex and
+// replacement strings.
+func RegexCompiledGsub(
+	input string,
+	regex *regexp.Regexp,
+	replacement string,
+	replacementCaptureMatrix [][]int,
+) string {
+	return regexCompiledSubOrGsub(input, regex, replacement, replacementCaptureMatrix, false)
+}
+
 // regexCompiledSubOrGsub is the implementation for `sub`/`gsub` with compilex regex
 // and replacement strings.
 func regexCompiledSubOrGsub(
@@ -188,3 +188,21 @@ func compareCaptures(
 	}
 	return true
 }
+
+func TestIsQuotedMillerRegex(t *testing.T) {
+	cases := map[string]bool{
+		`"abc"`:     true,
+		`"^a.*b$"i`: true,
+		`""`:        true,
+		`abc`:       false,
+		`"`:         false,
+		`"i`:        false,
+		`"abc`:      false,
+		`abc"i`:     false,
+	}
+	for input, expected := range cases {
+		if IsQuotedMillerRegex(input) != expected {
+			t.Fatalf("input %s expected %v\n", input, expected)
+		}
+	}
+}
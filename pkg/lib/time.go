@@ -50,8 +50,11 @@ func Nsec2LocationTime(epochNanoseconds int64, numDecimalPlaces int, location *t
 
 // secToFormattedTime is for DSL functions sec2gmt and sec2localtime. If doLocal is
 // false, use UTC.  Else if location is nil, use $TZ environment variable. Else
-// use the specified location.
+// use the specified location. NaN and infinities are returned as such.
 func secToFormattedTime(epochSeconds float64, numDecimalPlaces int, doLocal bool, location *time.Location) string {
+	if formatted, ok := FormatNonFiniteFloat(epochSeconds); ok {
+		return formatted
+	}
 	intPart := int64(epochSeconds)
 	fractionalPart := epochSeconds - float64(intPart)
 	if fractionalPart < 0 {
@@ -157,6 +160,13 @@ func EpochNanosecondsToLocationTime(epochNanoseconds int64, location *time.Locat
 	return epochNanosecondsToTime(epochNanoseconds, true, location)
 }
 
+// TimeToEpochSeconds is the inverse of EpochSecondsToGMT. Adding the
+// fractional part to the integer seconds, rather than dividing
+// t.UnixNano() by 1e9, keeps e.g. .25 from coming back as .2499998.
+func TimeToEpochSeconds(t time.Time) float64 {
+	return float64(t.Unix()) + float64(t.Nanosecond())/1.0e9
+}
+
 func epochSecondsToTime(epochSeconds float64, doLocal bool, location *time.Location) time.Time {
 	intPart := int64(epochSeconds)
 	fractionalPart := epochSeconds - float64(intPart)
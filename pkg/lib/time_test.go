@@ -99,3 +99,11 @@ func TestEpochNanosecondsToGMT(t *testing.T) {
 		assert.Equal(t, entry.expectedOutput, EpochNanosecondsToGMT(entry.epochNanoseconds))
 	}
 }
+
+func TestTimeToEpochSeconds(t *testing.T) {
+	for _, entry := range dataForEpochSecondsToGMT {
+		assert.Equal(t, entry.epochSeconds, TimeToEpochSeconds(entry.expectedOutput))
+	}
+	assert.Equal(t, 1546398245.25, TimeToEpochSeconds(time.Unix(1546398245, 250000000)))
+	assert.Equal(t, -1.5, TimeToEpochSeconds(time.Unix(-2, 500000000)))
+}
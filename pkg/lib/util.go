@@ -7,6 +7,8 @@ import (
 	"strconv"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/facette/natsort"
 )
 
 func BooleanXOR(a, b bool) bool {
@@ -59,6 +61,18 @@ func SortStrings(strings []string) {
 	})
 }
 
+// SortStringsNaturally sorts with embedded numbers compared numerically, so
+// that "f2" comes before "f10".
+func SortStringsNaturally(strings []string) {
+	sort.SliceStable(strings, func(i, j int) bool {
+		// natsort.Compare treats the empty string as equal to everything
+		if strings[i] == "" || strings[j] == "" {
+			return strings[i] < strings[j]
+		}
+		return natsort.Compare(strings[i], strings[j])
+	})
+}
+
 func ReverseStringList(strings []string) {
 	n := len(strings)
 	i := 0
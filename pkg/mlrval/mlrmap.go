@@ -10,6 +10,12 @@
 //
 // * It keeps a doubly-linked list of key-value pairs.
 //
+// * Iteration (Head to Tail via Next) is always in insertion order. Putting
+//   to an existing key updates the value in place without moving it.
+//   PrependReference, PutReferenceAfter, Rename, MoveToHead, and MoveToTail
+//   are the only ways to place an entry other than at the end; verbs such as
+//   cat -n, reorder, and rename rely on this.
+//
 // * With hash-records set to false, no hash functions are computed when the map
 //   is written to or read from.
 //
@@ -241,8 +241,8 @@ func (mlrmap *Mlrmap) findEntryByPositionalIndex(position int64) *MlrmapEntry {
 }
 
 func (mlrmap *Mlrmap) PutCopyWithMlrvalIndex(key *Mlrval, value *Mlrval) error {
-	if key.IsStringOrInt() {
-		mlrmap.PutCopy(key.String(), value)
+	if skey, ok := mapKeyFromMlrvalIndex(key); ok {
+		mlrmap.PutCopy(skey, value)
 		return nil
 	} else {
 		return fmt.Errorf(
@@ -372,10 +372,8 @@ func (mlrmap *Mlrmap) getWithMlrvalArrayIndex(index *Mlrval) (*Mlrval, error) {
 }
 
 func (mlrmap *Mlrmap) getWithMlrvalSingleIndex(index *Mlrval) (*Mlrval, error) {
-	if index.IsString() {
-		return mlrmap.Get(index.printrep), nil
-	} else if index.IsInt() {
-		return mlrmap.Get(index.String()), nil
+	if key, ok := mapKeyFromMlrvalIndex(index); ok {
+		return mlrmap.Get(key), nil
 	} else {
 		return nil, fmt.Errorf(
 			"Record/map indices must be string, int, or array thereof; got %s", index.GetTypeName(),
@@ -383,6 +381,22 @@ func (mlrmap *Mlrmap) getWithMlrvalSingleIndex(index *Mlrval) (*Mlrval, error) {
 	}
 }
 
+// mapKeyFromMlrvalIndex returns the map key for a DSL index. Map keys are
+// strings, so int, float, and boolean indices are stringified. This is used
+// for both reads and writes so that e.g. '@m[1.5] = 3' can be read back
+// using '@m[1.5]'.
+func mapKeyFromMlrvalIndex(index *Mlrval) (string, bool) {
+	if index.IsStringOrVoid() {
+		return index.printrep, true
+	} else if index.IsInt() {
+		return index.String(), true
+	} else if index.IsFloat() || index.IsBool() {
+		return index.OriginalString(), true
+	} else {
+		return "", false
+	}
+}
+
 // For '$[[1]]' etc. in the DSL.
 //
 // Notes:
@@ -485,6 +499,8 @@ func (mlrmap *Mlrmap) Remove(key string) bool {
 	}
 }
 
+// MoveToHead moves an existing entry to the front, leaving the others in
+// their original order. No-op if the key is absent.
 func (mlrmap *Mlrmap) MoveToHead(key string) {
 	pe := mlrmap.findEntry(key)
 	if pe != nil {
@@ -493,6 +509,8 @@ func (mlrmap *Mlrmap) MoveToHead(key string) {
 	}
 }
 
+// MoveToTail moves an existing entry to the end, leaving the others in
+// their original order. No-op if the key is absent.
 func (mlrmap *Mlrmap) MoveToTail(key string) {
 	pe := mlrmap.findEntry(key)
 	if pe != nil {
@@ -700,12 +718,20 @@ func (mlrmap *Mlrmap) GetValuesJoinedExcept(px *MlrmapEntry) string {
 }
 
 // ----------------------------------------------------------------
+// Rename changes the key of an entry while keeping its position. If the new
+// key is already present, the renamed entry's value goes into that entry's
+// position and the old entry is removed. Returns false if oldKey is absent.
 func (mlrmap *Mlrmap) Rename(oldKey string, newKey string) bool {
 	entry := mlrmap.findEntry(oldKey)
 	if entry == nil {
 		// Rename field from 'a' to 'b' where there is no 'a': no-op
 		return false
 	}
+	if oldKey == newKey {
+		// Rename field from 'a' to 'a': no-op, rather than unlinking 'a' as
+		// the both-present case below would do
+		return true
+	}
 
 	existing := mlrmap.findEntry(newKey)
 	if existing == nil {
@@ -770,33 +796,30 @@ func (mlrmap *Mlrmap) Label(newNames []string) {
 
 // ----------------------------------------------------------------
 func (mlrmap *Mlrmap) SortByKey() {
-	keys := mlrmap.GetKeys()
-
-	lib.SortStrings(keys)
-
-	other := NewMlrmapAsRecord()
-
-	for _, key := range keys {
-		// Old record will be GC'ed: just move pointers
-		other.PutReference(key, mlrmap.Get(key))
-	}
-
-	*mlrmap = *other
+	mlrmap.SortByKeyWith(lib.SortStrings, false)
 }
 
 // ----------------------------------------------------------------
 func (mlrmap *Mlrmap) SortByKeyRecursively() {
+	mlrmap.SortByKeyWith(lib.SortStrings, true)
+}
+
+// ----------------------------------------------------------------
+// SortByKeyWith reorders the fields using the given in-place sorter on the
+// keys, such as lib.SortStrings. With recurse, map-valued fields are sorted
+// the same way.
+func (mlrmap *Mlrmap) SortByKeyWith(sortKeys func(keys []string), recurse bool) {
 	keys := mlrmap.GetKeys()
 
-	lib.SortStrings(keys)
+	sortKeys(keys)
 
 	other := NewMlrmapAsRecord()
 
 	for _, key := range keys {
 		// Old record will be GC'ed: just move pointers
 		val := mlrmap.Get(key)
-		if val.IsMap() {
-			val.intf.(*Mlrmap).SortByKeyRecursively()
+		if recurse && val.IsMap() {
+			val.intf.(*Mlrmap).SortByKeyWith(sortKeys, recurse)
 		}
 		other.PutReference(key, val)
 	}
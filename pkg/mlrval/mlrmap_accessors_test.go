@@ -46,7 +46,92 @@ func TestPutReference(t *testing.T) {
 	assert.NotNil(t, read)
 }
 
-// TODO: TestPrependReference
+func newOrderTestMlrmaps() []*Mlrmap {
+	mlrmaps := []*Mlrmap{NewMlrmapMaybeHashed(true), NewMlrmapMaybeHashed(false)}
+	for _, mlrmap := range mlrmaps {
+		mlrmap.PutReference("a", FromInt(1))
+		mlrmap.PutReference("b", FromInt(2))
+		mlrmap.PutReference("c", FromInt(3))
+	}
+	return mlrmaps
+}
+
+func TestInsertionOrder(t *testing.T) {
+	for _, mlrmap := range newOrderTestMlrmaps() {
+		assert.Equal(t, []string{"a", "b", "c"}, mlrmap.GetKeys())
+
+		// Update in place does not move the entry
+		mlrmap.PutReference("a", FromInt(10))
+		assert.Equal(t, []string{"a", "b", "c"}, mlrmap.GetKeys())
+		assert.Equal(t, "10", mlrmap.Get("a").String())
+
+		mlrmap.Remove("b")
+		mlrmap.PutReference("b", FromInt(20))
+		assert.Equal(t, []string{"a", "c", "b"}, mlrmap.GetKeys())
+		assert.Equal(t, "a=10,c=3,b=20", mlrmap.ToDKVPString())
+	}
+}
+
+func TestPrependReference(t *testing.T) {
+	for _, mlrmap := range newOrderTestMlrmaps() {
+		mlrmap.PrependReference("z", FromInt(0))
+		assert.Equal(t, []string{"z", "a", "b", "c"}, mlrmap.GetKeys())
+		assert.Equal(t, int64(4), mlrmap.FieldCount)
+
+		// Existing key: value updated, position kept
+		mlrmap.PrependReference("b", FromInt(5))
+		assert.Equal(t, []string{"z", "a", "b", "c"}, mlrmap.GetKeys())
+		assert.Equal(t, "5", mlrmap.Get("b").String())
+	}
+}
+
+func TestRenamePreservesPosition(t *testing.T) {
+	for _, mlrmap := range newOrderTestMlrmaps() {
+		assert.True(t, mlrmap.Rename("b", "x"))
+		assert.Equal(t, []string{"a", "x", "c"}, mlrmap.GetKeys())
+		assert.False(t, mlrmap.Has("b"))
+		assert.Equal(t, "2", mlrmap.Get("x").String())
+
+		assert.False(t, mlrmap.Rename("nosuch", "y"))
+		assert.Equal(t, []string{"a", "x", "c"}, mlrmap.GetKeys())
+
+		assert.True(t, mlrmap.Rename("x", "x"))
+		assert.Equal(t, []string{"a", "x", "c"}, mlrmap.GetKeys())
+		assert.Equal(t, "2", mlrmap.Get("x").String())
+
+		// Renaming onto an existing key takes that key's position
+		assert.True(t, mlrmap.Rename("c", "a"))
+		assert.Equal(t, []string{"a", "x"}, mlrmap.GetKeys())
+		assert.Equal(t, "3", mlrmap.Get("a").String())
+		assert.Equal(t, int64(2), mlrmap.FieldCount)
+	}
+}
+
+func TestMoveToHeadAndTail(t *testing.T) {
+	for _, mlrmap := range newOrderTestMlrmaps() {
+		mlrmap.MoveToHead("c")
+		assert.Equal(t, []string{"c", "a", "b"}, mlrmap.GetKeys())
+		mlrmap.MoveToHead("c")
+		assert.Equal(t, []string{"c", "a", "b"}, mlrmap.GetKeys())
+
+		mlrmap.MoveToTail("c")
+		assert.Equal(t, []string{"a", "b", "c"}, mlrmap.GetKeys())
+		mlrmap.MoveToTail("a")
+		assert.Equal(t, []string{"b", "c", "a"}, mlrmap.GetKeys())
+
+		mlrmap.MoveToHead("nosuch")
+		mlrmap.MoveToTail("nosuch")
+		assert.Equal(t, []string{"b", "c", "a"}, mlrmap.GetKeys())
+		assert.Equal(t, int64(3), mlrmap.FieldCount)
+
+		// Backward links are kept consistent too
+		assert.Equal(t, "a", mlrmap.Tail.Key)
+		assert.Equal(t, "c", mlrmap.Tail.Prev.Key)
+		assert.Equal(t, "b", mlrmap.Tail.Prev.Prev.Key)
+		assert.Nil(t, mlrmap.Head.Prev)
+		assert.Equal(t, "3", mlrmap.Get("c").String())
+	}
+}
 
 func TestGetKeysExcept(t *testing.T) {
 	mlrmap := NewMlrmap()
@@ -66,3 +151,50 @@ func TestGetKeysExcept(t *testing.T) {
 	exceptions["b"] = true
 	assert.Equal(t, mlrmap.GetKeysExcept(exceptions), []string{})
 }
+
+func TestGetWithMlrvalIndex(t *testing.T) {
+	mlrmap := NewMlrmap()
+	mlrmap.PutReference("3", FromString("int"))
+	mlrmap.PutReference("1.5", FromString("float"))
+	mlrmap.PutReference("true", FromString("bool"))
+
+	read, err := mlrmap.GetWithMlrvalIndex(FromInt(3))
+	assert.Nil(t, err)
+	assert.Equal(t, "int", read.String())
+
+	read, err = mlrmap.GetWithMlrvalIndex(FromInferredType("1.5"))
+	assert.Nil(t, err)
+	assert.Equal(t, "float", read.String())
+
+	read, err = mlrmap.GetWithMlrvalIndex(FromBool(true))
+	assert.Nil(t, err)
+	assert.Equal(t, "bool", read.String())
+
+	read, err = mlrmap.GetWithMlrvalIndex(FromString("nonesuch"))
+	assert.Nil(t, err)
+	assert.Nil(t, read)
+
+	_, err = mlrmap.GetWithMlrvalIndex(FromMap(NewMlrmap()))
+	assert.NotNil(t, err)
+}
+
+func TestPutIndexedWithFloatAndBoolIndices(t *testing.T) {
+	mlrmap := NewMlrmap()
+
+	err := mlrmap.PutIndexed([]*Mlrval{FromInferredType("1.5")}, FromInt(3))
+	assert.Nil(t, err)
+	err = mlrmap.PutIndexed([]*Mlrval{FromBool(true), FromString("x")}, FromInt(4))
+	assert.Nil(t, err)
+	assert.Equal(t, "1.5,true", mlrmap.GetKeysJoined())
+
+	read, err := mlrmap.GetWithMlrvalIndex(FromInferredType("1.5"))
+	assert.Nil(t, err)
+	assert.Equal(t, "3", read.String())
+
+	err = mlrmap.RemoveIndexed([]*Mlrval{FromInferredType("1.5")})
+	assert.Nil(t, err)
+	assert.Equal(t, "true", mlrmap.GetKeysJoined())
+
+	err = mlrmap.PutIndexed([]*Mlrval{FromMap(NewMlrmap())}, FromInt(5))
+	assert.NotNil(t, err)
+}
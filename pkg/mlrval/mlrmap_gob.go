@@ -0,0 +1,127 @@
+// ================================================================
+// Lossless encoding of records for spilling to temp files, e.g. by tac.
+//
+// Writing records out as JSON and reading them back in would lose
+// information: ints written in hex, floats with user-specified formatting,
+// values not yet type-inferred, etc. Here we keep the type, the print
+// representation, and the payload so that a record read back in is
+// indistinguishable from the one written out.
+// ================================================================
+
+package mlrval
+
+import (
+	"errors"
+)
+
+// GobMlrmap is a gob-encodable image of a Mlrmap.
+type GobMlrmap struct {
+	Keys   []string
+	Values []*GobMlrval
+}
+
+// GobMlrval is a gob-encodable image of a Mlrval.
+type GobMlrval struct {
+	Type          MVType
+	Printrep      string
+	PrintrepValid bool
+	FloatOrigin   floatOrigin
+	Intval        int64
+	Floatval      float64
+	Boolval       bool
+	Errval        string
+	Arrayval      []*GobMlrval
+	Mapval        *GobMlrmap
+}
+
+// ToGob makes a gob-encodable image of the map.
+func (mlrmap *Mlrmap) ToGob() *GobMlrmap {
+	gobmap := &GobMlrmap{
+		Keys:   make([]string, 0, mlrmap.FieldCount),
+		Values: make([]*GobMlrval, 0, mlrmap.FieldCount),
+	}
+	for pe := mlrmap.Head; pe != nil; pe = pe.Next {
+		gobmap.Keys = append(gobmap.Keys, pe.Key)
+		gobmap.Values = append(gobmap.Values, pe.Value.ToGob())
+	}
+	return gobmap
+}
+
+// ToGob makes a gob-encodable image of the mlrval. Values of pending type are
+// kept as such, without forcing type inference.
+func (mv *Mlrval) ToGob() *GobMlrval {
+	gobval := &GobMlrval{
+		Type:          mv.mvtype,
+		Printrep:      mv.printrep,
+		PrintrepValid: mv.printrepValid,
+		FloatOrigin:   mv.floatOrigin,
+	}
+	switch mv.mvtype {
+	case MT_INT:
+		gobval.Intval = mv.intf.(int64)
+	case MT_FLOAT:
+		gobval.Floatval = mv.intf.(float64)
+	case MT_BOOL:
+		gobval.Boolval = mv.intf.(bool)
+	case MT_ERROR:
+		if mv.err != nil {
+			gobval.Errval = mv.err.Error()
+		}
+	case MT_ARRAY:
+		arrayval := mv.intf.([]*Mlrval)
+		gobval.Arrayval = make([]*GobMlrval, len(arrayval))
+		for i, element := range arrayval {
+			gobval.Arrayval[i] = element.ToGob()
+		}
+		// Array and map printreps are recomputed on output
+		gobval.PrintrepValid = false
+	case MT_MAP:
+		gobval.Mapval = mv.intf.(*Mlrmap).ToGob()
+		gobval.PrintrepValid = false
+	}
+	return gobval
+}
+
+// ToMlrmap is the inverse of Mlrmap.ToGob. The result is a record-style map,
+// hashed or not according to the --hash-records setting.
+func (gobmap *GobMlrmap) ToMlrmap() *Mlrmap {
+	return gobmap.toMlrmap(NewMlrmapAsRecord())
+}
+
+func (gobmap *GobMlrmap) toMlrmap(mlrmap *Mlrmap) *Mlrmap {
+	for i, key := range gobmap.Keys {
+		mlrmap.PutReference(key, gobmap.Values[i].ToMlrval())
+	}
+	return mlrmap
+}
+
+// ToMlrval is the inverse of Mlrval.ToGob.
+func (gobval *GobMlrval) ToMlrval() *Mlrval {
+	mv := &Mlrval{
+		mvtype:        gobval.Type,
+		printrep:      gobval.Printrep,
+		printrepValid: gobval.PrintrepValid,
+		floatOrigin:   gobval.FloatOrigin,
+	}
+	switch gobval.Type {
+	case MT_INT:
+		mv.intf = gobval.Intval
+	case MT_FLOAT:
+		mv.intf = gobval.Floatval
+	case MT_BOOL:
+		mv.intf = gobval.Boolval
+	case MT_ERROR:
+		if gobval.Errval != "" {
+			mv.err = errors.New(gobval.Errval)
+		}
+	case MT_ARRAY:
+		arrayval := make([]*Mlrval, len(gobval.Arrayval))
+		for i, element := range gobval.Arrayval {
+			arrayval[i] = element.ToMlrval()
+		}
+		mv.intf = arrayval
+	case MT_MAP:
+		mv.intf = gobval.Mapval.toMlrmap(NewMlrmap())
+	}
+	return mv
+}
@@ -0,0 +1,43 @@
+package mlrval
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMlrmapGobRoundTrip(t *testing.T) {
+	formatter, err := GetFormatter("%.2f")
+	assert.Nil(t, err)
+
+	input := NewMlrmapAsRecord()
+	input.PutReference("pending", FromDeferredType("0xff"))
+	input.PutReference("int", FromInt(17))
+	input.PutReference("float", FromFloat(1.5))
+	input.PutReference("formatted", formatter.Format(FromFloat(3.14159)))
+	input.PutReference("bool", FromBool(true))
+	input.PutReference("void", FromString(""))
+	input.PutReference("string", FromString("abc"))
+	input.PutReference("array", FromArray([]*Mlrval{FromInt(1), FromString("x")}))
+	inner := NewMlrmap()
+	inner.PutReference("y", FromFloat(2.5))
+	input.PutReference("map", FromMap(inner))
+
+	var buffer bytes.Buffer
+	assert.Nil(t, gob.NewEncoder(&buffer).Encode(input.ToGob()))
+	var gobmap GobMlrmap
+	assert.Nil(t, gob.NewDecoder(&buffer).Decode(&gobmap))
+	output := gobmap.ToMlrmap()
+
+	assert.Equal(t, input.FieldCount, output.FieldCount)
+	for pi, po := input.Head, output.Head; pi != nil; pi, po = pi.Next, po.Next {
+		assert.Equal(t, pi.Key, po.Key)
+		assert.Equal(t, pi.Value.Type(), po.Value.Type())
+		assert.Equal(t, pi.Value.String(), po.Value.String())
+	}
+	assert.Equal(t, "0xff", output.Get("pending").String())
+	assert.Equal(t, floatComputed, output.Get("float").floatOrigin)
+	assert.Equal(t, floatFormatted, output.Get("formatted").floatOrigin)
+}
@@ -246,10 +246,8 @@ func (mv *Mlrval) MapPut(key *Mlrval, value *Mlrval) {
 		return
 	}
 
-	if key.IsString() {
-		mv.intf.(*Mlrmap).PutCopy(key.printrep, value)
-	} else if key.IsInt() {
-		mv.intf.(*Mlrmap).PutCopy(key.String(), value)
+	if skey, ok := mapKeyFromMlrvalIndex(key); ok {
+		mv.intf.(*Mlrmap).PutCopy(skey, value)
 	}
 	// TODO: need to be careful about semantics here.
 	// Silent no-ops are not good UX ...
@@ -270,7 +268,8 @@ func (mv *Mlrval) MapPut(key *Mlrval, value *Mlrval) {
 // * If it's a map-type mlrval then:
 //
 //   o Strings are map keys.
-//   o Integers are stringified, then interpreted as map keys.
+//   o Integers, floats, and booleans are stringified, then interpreted as map
+//     keys.
 //
 // * If it's an array-type mlrval then:
 //
@@ -305,7 +304,7 @@ func (mv *Mlrval) PutIndexed(indices []*Mlrval, rvalue *Mlrval) error {
 
 	} else {
 		baseIndex := indices[0]
-		if baseIndex.IsString() {
+		if baseIndex.IsString() || baseIndex.IsFloat() || baseIndex.IsBool() {
 			*mv = *FromEmptyMap()
 			return putIndexedOnMap(mv.intf.(*Mlrmap), indices, rvalue)
 		} else if baseIndex.IsInt() {
@@ -331,7 +330,7 @@ func putIndexedOnMap(baseMap *Mlrmap, indices []*Mlrval, rvalue *Mlrval) error {
 		// E.g. mlr put '$* = {"a":1, "b":2}'
 		if !rvalue.IsMap() {
 			return errors.New(
-				"Cannot assign non-map to existing map; got " +
+				"mlr: cannot assign non-map to existing map; got " +
 					rvalue.GetTypeName() +
 					".",
 			)
@@ -348,14 +347,15 @@ func putIndexedOnMap(baseMap *Mlrmap, indices []*Mlrval, rvalue *Mlrval) error {
 	}
 
 	// If not last index, then recurse.
-	if !baseIndex.IsString() && !baseIndex.IsInt() {
+	baseKey, ok := mapKeyFromMlrvalIndex(baseIndex)
+	if !ok {
 		// Base is map, index is invalid type
 		return errors.New(
 			"mlr: map indices must be string, int, or array thereof; got " + baseIndex.GetTypeName(),
 		)
 	}
 
-	baseValue := baseMap.Get(baseIndex.String())
+	baseValue := baseMap.Get(baseKey)
 	if baseValue == nil {
 		// Create a new level in order to recurse from
 		nextIndex := indices[1]
@@ -365,7 +365,7 @@ func putIndexedOnMap(baseMap *Mlrmap, indices []*Mlrval, rvalue *Mlrval) error {
 		if err != nil {
 			return err
 		}
-		baseMap.PutReference(baseIndex.String(), baseValue)
+		baseMap.PutReference(baseKey, baseValue)
 	}
 	return baseValue.PutIndexed(indices[1:], rvalue)
 }
@@ -414,7 +414,7 @@ func putIndexedOnArray(
 			nextIndex := indices[1]
 
 			// Overwrite what's in this slot if it's the wrong type
-			if nextIndex.IsString() {
+			if nextIndex.IsString() || nextIndex.IsFloat() || nextIndex.IsBool() {
 				if !(*baseArray)[zindex].IsMap() {
 					(*baseArray)[zindex] = FromEmptyMap()
 				}
@@ -472,8 +472,8 @@ func removeIndexedOnMap(baseMap *Mlrmap, indices []*Mlrval) error {
 
 	// If last index, then unset.
 	if numIndices == 1 {
-		if baseIndex.IsString() || baseIndex.IsInt() {
-			baseMap.Remove(baseIndex.String())
+		if baseKey, ok := mapKeyFromMlrvalIndex(baseIndex); ok {
+			baseMap.Remove(baseKey)
 			return nil
 		} else {
 			return errors.New(
@@ -484,9 +484,9 @@ func removeIndexedOnMap(baseMap *Mlrmap, indices []*Mlrval) error {
 	}
 
 	// If not last index, then recurse.
-	if baseIndex.IsString() || baseIndex.IsInt() {
+	if baseKey, ok := mapKeyFromMlrvalIndex(baseIndex); ok {
 		// Base is map, index is string
-		baseValue := baseMap.Get(baseIndex.String())
+		baseValue := baseMap.Get(baseKey)
 		if baseValue != nil {
 			return baseValue.RemoveIndexed(indices[1:])
 		}
@@ -557,97 +557,45 @@ type BsearchMlrvalArrayFunc func(
 	value *Mlrval,
 ) int64
 
+// BsearchMlrvalArrayForDescendingInsert returns the index at which to insert
+// the value into the descending-sorted array. Since the index is after any
+// elements equal to the value, ties are resolved in favor of the elements
+// which were inserted first.
 func BsearchMlrvalArrayForDescendingInsert(
 	array *[]*Mlrval,
 	size int64, // maybe less than len(array)
 	value *Mlrval,
 ) int64 {
 	lo := int64(0)
-	hi := size - 1
-	mid := (hi + lo) / 2
-	var newmid int64
-
-	if size == 0 {
-		return 0
-	}
-
-	if GreaterThan(value, (*array)[0]) {
-		return 0
-	}
-	if LessThan(value, (*array)[hi]) {
-		return size
-	}
-
+	hi := size
 	for lo < hi {
-		middleElement := (*array)[mid]
-		if Equals(value, middleElement) {
-			return mid
-		} else if GreaterThan(value, middleElement) {
+		mid := lo + (hi-lo)/2
+		if LessThan((*array)[mid], value) {
 			hi = mid
-			newmid = (hi + lo) / 2
 		} else {
-			lo = mid
-			newmid = (hi + lo) / 2
+			lo = mid + 1
 		}
-		if mid == newmid {
-			if GreaterThanOrEquals(value, (*array)[lo]) {
-				return lo
-			} else if GreaterThanOrEquals(value, (*array)[hi]) {
-				return hi
-			} else {
-				return hi + 1
-			}
-		}
-		mid = newmid
 	}
-
 	return lo
 }
 
+// BsearchMlrvalArrayForAscendingInsert is as BsearchMlrvalArrayForDescendingInsert
+// but for ascending-sorted arrays.
 func BsearchMlrvalArrayForAscendingInsert(
 	array *[]*Mlrval,
 	size int64, // maybe less than len(array)
 	value *Mlrval,
 ) int64 {
 	lo := int64(0)
-	hi := size - 1
-	mid := (hi + lo) / 2
-	var newmid int64
-
-	if size == 0 {
-		return 0
-	}
-
-	if LessThan(value, (*array)[0]) {
-		return 0
-	}
-	if GreaterThan(value, (*array)[hi]) {
-		return size
-	}
-
+	hi := size
 	for lo < hi {
-		middleElement := (*array)[mid]
-		if Equals(value, middleElement) {
-			return mid
-		} else if LessThan(value, middleElement) {
+		mid := lo + (hi-lo)/2
+		if GreaterThan((*array)[mid], value) {
 			hi = mid
-			newmid = (hi + lo) / 2
 		} else {
-			lo = mid
-			newmid = (hi + lo) / 2
+			lo = mid + 1
 		}
-		if mid == newmid {
-			if LessThanOrEquals(value, (*array)[lo]) {
-				return lo
-			} else if LessThanOrEquals(value, (*array)[hi]) {
-				return hi
-			} else {
-				return hi + 1
-			}
-		}
-		mid = newmid
 	}
-
 	return lo
 }
 
@@ -660,7 +608,7 @@ func BsearchMlrvalArrayForAscendingInsert(
 //	$foo[1]["a"] ??= []
 //	$foo[1]["a"][2]["b"] = 3
 func NewMlrvalForAutoDeepen(mvtype MVType) (*Mlrval, error) {
-	if mvtype == MT_STRING || mvtype == MT_INT {
+	if mvtype == MT_STRING || mvtype == MT_INT || mvtype == MT_FLOAT || mvtype == MT_BOOL {
 		empty := FromEmptyMap()
 		return empty, nil
 	} else {
@@ -8,6 +8,8 @@ import (
 
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
+
+	"github.com/johnkerl/miller/pkg/lib"
 )
 
 //----------------------------------------------------------------
@@ -54,6 +56,41 @@ func SetFloatOutputFormat(formatString string) error {
 	return nil
 }
 
+// tryFromFormattedFloatString is for the output of float formats. The result
+// is exempt from --ofmt, since the user has already asked for a format.
+func tryFromFormattedFloatString(formatted string) *Mlrval {
+	mv := TryFromFloatString(formatted)
+	if mv.mvtype == MT_FLOAT {
+		mv.floatOrigin = floatFormatted
+	}
+	return mv
+}
+
+// FormatFloat is for float output, using the given formatter, or Miller's
+// default float formatting if the formatter is nil. NaN and infinities are
+// always written as "NaN", "+Inf", and "-Inf": printf-style formats would
+// otherwise space-pad them, or for int formats, print meaningless integers.
+func FormatFloat(floatValue float64, formatter IFormatter) string {
+	if formatted, ok := lib.FormatNonFiniteFloat(floatValue); ok {
+		return formatted
+	}
+	if formatter == nil {
+		return strconv.FormatFloat(floatValue, 'f', -1, 64)
+	}
+	return formatter.FormatFloat(floatValue)
+}
+
+// isNonFiniteFloat is for the numeric formatters, which pass NaN and infinities
+// through unmodified.
+func isNonFiniteFloat(mv *Mlrval) bool {
+	floatValue, isFloat := mv.GetFloatValue()
+	if !isFloat {
+		return false
+	}
+	_, nonFinite := lib.FormatNonFiniteFloat(floatValue)
+	return nonFinite
+}
+
 var formatterCache map[string]IFormatter = make(map[string]IFormatter)
 
 type IFormatter interface {
@@ -159,15 +196,18 @@ func newFormatterToFloat(goFormatString string) IFormatter {
 }
 
 func (formatter *formatterToFloat) Format(mv *Mlrval) *Mlrval {
+	if isNonFiniteFloat(mv) {
+		return mv
+	}
 	floatValue, isFloat := mv.GetFloatValue()
 	if isFloat {
 		formatted := fmt.Sprintf(formatter.goFormatString, floatValue)
-		return TryFromFloatString(formatted)
+		return tryFromFormattedFloatString(formatted)
 	}
 	intValue, isInt := mv.GetIntValue()
 	if isInt {
 		formatted := fmt.Sprintf(formatter.goFormatString, float64(intValue))
-		return TryFromFloatString(formatted)
+		return tryFromFormattedFloatString(formatted)
 	}
 	return mv
 }
@@ -202,6 +242,9 @@ func newFormatterToSeparatedInt(goFormatString string) IFormatter {
 }
 
 func (formatter *formatterToSeparatedInt) Format(mv *Mlrval) *Mlrval {
+	if isNonFiniteFloat(mv) {
+		return mv
+	}
 	intValue, isInt := mv.GetIntValue()
 	if isInt {
 		formatted := formatter.printer.Sprintf(formatter.goFormatString, intValue)
@@ -234,15 +277,18 @@ func newFormatterToSeparatedFloat(goFormatString string) IFormatter {
 }
 
 func (formatter *formatterToSeparatedFloat) Format(mv *Mlrval) *Mlrval {
+	if isNonFiniteFloat(mv) {
+		return mv
+	}
 	floatValue, isFloat := mv.GetFloatValue()
 	if isFloat {
 		formatted := formatter.printer.Sprintf(formatter.goFormatString, floatValue)
-		return TryFromFloatString(formatted)
+		return tryFromFormattedFloatString(formatted)
 	}
 	intValue, isInt := mv.GetIntValue()
 	if isInt {
 		formatted := formatter.printer.Sprintf(formatter.goFormatString, float64(intValue))
-		return TryFromFloatString(formatted)
+		return tryFromFormattedFloatString(formatted)
 	}
 	return mv
 }
@@ -264,6 +310,9 @@ func newFormatterToInt(goFormatString string) IFormatter {
 }
 
 func (formatter *formatterToInt) Format(mv *Mlrval) *Mlrval {
+	if isNonFiniteFloat(mv) {
+		return mv
+	}
 	intValue, isInt := mv.GetIntValue()
 	if isInt {
 		formatted := fmt.Sprintf(formatter.goFormatString, intValue)
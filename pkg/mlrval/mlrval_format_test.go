@@ -1,6 +1,7 @@
 package mlrval
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -170,3 +171,31 @@ func TestFormatter(t *testing.T) {
 	assert.True(t, fmv.IsInt())
 	assert.Equal(t, "10", fmv.String())
 }
+
+func TestFormatNonFiniteFloats(t *testing.T) {
+	inputs := map[string]float64{
+		"NaN":  math.NaN(),
+		"+Inf": math.Inf(1),
+		"-Inf": math.Inf(-1),
+	}
+
+	for expected, input := range inputs {
+		assert.Equal(t, expected, FormatFloat(input, nil))
+		assert.Equal(t, expected, FromFloat(input).String())
+
+		for _, formatString := range []string{"%.4f", "%08.3lf", "%_f", "%.2e", "%d", "%08llx", "%_d"} {
+			formatter, err := GetFormatter(formatString)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, FormatFloat(input, formatter), formatString)
+			fmv := formatter.Format(FromFloat(input))
+			assert.True(t, fmv.IsFloat(), formatString)
+			assert.Equal(t, expected, fmv.String(), formatString)
+		}
+	}
+
+	// Finite values are formatted as usual
+	formatter, err := GetFormatter("%.3f")
+	assert.Nil(t, err)
+	assert.Equal(t, "1.500", FormatFloat(1.5, formatter))
+	assert.Equal(t, "1.5", FormatFloat(1.5, nil))
+}
@@ -10,7 +10,9 @@ import (
 
 // It's essential that we use mv.Type() not mv.mvtype since types are
 // JIT-computed on first access for most data-file values. See type.go for more
-// information.
+// information. The inferred type is remembered in mv.mvtype, and the original
+// string in mv.printrep, so a value is scanned at most once no matter how many
+// times it's accessed, and is written back out as it was read in.
 
 func (mv *Mlrval) Type() MVType {
 	if mv.mvtype == MT_PENDING {
@@ -51,7 +53,8 @@ func inferNormally(mv *Mlrval) *Mlrval {
 	return normalInferrerTable[scanType](mv)
 }
 
-// xxx temp
+// inferWithOctalAsInt is for mlr -O. Ints with leading zeroes, like 0377, are
+// octal if they can be, else decimal; without -O they're strings.
 func inferWithOctalAsInt(mv *Mlrval) *Mlrval {
 	scanType := scan.FindScanType(mv.printrep)
 	return leadingZeroAsIntInferrerTable[scanType](mv)
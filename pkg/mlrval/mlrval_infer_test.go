@@ -69,6 +69,42 @@ func TestInferNormally(t *testing.T) {
 	assert.True(t, inferNormally(FromDeferredType("-.2e3")).IsFloat())
 	assert.True(t, inferNormally(FromDeferredType(".2e-3")).IsFloat())
 	assert.True(t, inferNormally(FromDeferredType("-.2e-3")).IsFloat())
+
+	assert.True(t, inferNormally(FromDeferredType("nan")).IsString())
+	assert.True(t, inferNormally(FromDeferredType("NaN")).IsString())
+	assert.True(t, inferNormally(FromDeferredType("-inf")).IsString())
+}
+
+// Leading zeroes are strings; 0x prefixes are hex; exponents are float.
+func TestInferFromDataExamples(t *testing.T) {
+	mv := FromDeferredType("007")
+	assert.Equal(t, MT_STRING, mv.Type())
+	assert.Equal(t, "007", mv.String())
+
+	mv = FromDeferredType("0x1f")
+	intval, ok := mv.GetIntValue()
+	assert.True(t, ok)
+	assert.Equal(t, int64(31), intval)
+	assert.Equal(t, "0x1f", mv.String(), "original formatting should be kept")
+
+	mv = FromDeferredType("1e3")
+	floatval, ok := mv.GetFloatValue()
+	assert.True(t, ok)
+	assert.Equal(t, 1000.0, floatval)
+	assert.Equal(t, "1e3", mv.String(), "original formatting should be kept")
+
+	mv = FromDeferredType("nan")
+	assert.Equal(t, MT_STRING, mv.Type())
+}
+
+// Inference happens once, on first access, and is remembered.
+func TestInferenceIsCached(t *testing.T) {
+	mv := FromDeferredType("0x1f")
+	assert.Equal(t, MT_PENDING, mv.mvtype)
+	assert.Equal(t, "0x1f", mv.String(), "String() should not need inference")
+	assert.Equal(t, MT_PENDING, mv.mvtype)
+	assert.Equal(t, MT_INT, mv.Type())
+	assert.Equal(t, MT_INT, mv.mvtype)
 }
 
 func TestInferWithOctalAsInt(t *testing.T) {
@@ -131,6 +167,25 @@ func TestInferWithOctalAsInt(t *testing.T) {
 	assert.True(t, inferWithOctalAsInt(FromDeferredType("-.2e-3")).IsFloat())
 }
 
+// With -O, leading-zero ints are octal if they can be, else decimal.
+func TestInferWithOctalAsIntValues(t *testing.T) {
+	intval, ok := inferWithOctalAsInt(FromDeferredType("007")).GetIntValue()
+	assert.True(t, ok)
+	assert.Equal(t, int64(7), intval)
+
+	intval, ok = inferWithOctalAsInt(FromDeferredType("0377")).GetIntValue()
+	assert.True(t, ok)
+	assert.Equal(t, int64(255), intval)
+
+	intval, ok = inferWithOctalAsInt(FromDeferredType("08")).GetIntValue()
+	assert.True(t, ok)
+	assert.Equal(t, int64(8), intval)
+
+	intval, ok = inferWithOctalAsInt(FromDeferredType("06789")).GetIntValue()
+	assert.True(t, ok)
+	assert.Equal(t, int64(6789), intval)
+}
+
 func TestInferWithIntAsFloat(t *testing.T) {
 	assert.True(t, inferWithIntAsFloat(FromDeferredType("")).IsVoid())
 
@@ -13,6 +13,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/johnkerl/miller/pkg/colorizer"
 	"github.com/johnkerl/miller/pkg/lib"
@@ -142,6 +143,18 @@ func MlrvalDecodeFromJSON(decoder *json.Decoder) (
 		return nil, false, err
 	}
 
+	return MlrvalDecodeFromJSONStartToken(decoder, startToken)
+}
+
+// MlrvalDecodeFromJSONStartToken is for callers which have already read the
+// first token of a value from the decoder, e.g. the JSON record-reader, which
+// needs to see whether the top-level value is an array so it can stream its
+// elements rather than decoding the whole array at once.
+func MlrvalDecodeFromJSONStartToken(decoder *json.Decoder, startToken json.Token) (
+	mlrval *Mlrval,
+	eof bool,
+	err error,
+) {
 	delimiter, isDelim := startToken.(json.Delim)
 	if !isDelim {
 		if startToken == nil {
@@ -181,7 +194,7 @@ func MlrvalDecodeFromJSON(decoder *json.Decoder) (
 		} else if delimiter == '{' {
 			isArray = false
 			expectedClosingDelimiter = '}'
-			collectionType = "JSON object`"
+			collectionType = "JSON object"
 		} else {
 			return nil, false, fmt.Errorf(
 				"mlr: JSON reader: Unhandled opening delimiter \"%s\"", string(delimiter),
@@ -397,9 +410,35 @@ func (mv *Mlrval) marshalJSONInt(outputIsStdout bool) (string, error) {
 }
 
 // ----------------------------------------------------------------
+// Computed floats with integral values, such as 2.0 * 3, format without a
+// decimal point. Those get a ".0" so that reading the JSON back in gives a
+// float, not an int. Floats from input data, including ints read as floats
+// with mlr -A, keep their original formatting, as do floats formatted by
+// fmtnum and the like.
 func (mv *Mlrval) marshalJSONFloat(outputIsStdout bool) (string, error) {
 	lib.InternalCodingErrorIf(mv.mvtype != MT_FLOAT)
-	return colorizer.MaybeColorizeValue(mv.String(), outputIsStdout), nil
+	s := mv.String()
+	if mv.floatOrigin == floatComputed && isDecimalIntegerString(s) {
+		s += ".0"
+	}
+	return colorizer.MaybeColorizeValue(s, outputIsStdout), nil
+}
+
+// isDecimalIntegerString is true for strings like "6" and "-12": an optional
+// sign followed by one or more decimal digits.
+func isDecimalIntegerString(s string) bool {
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
 }
 
 // ----------------------------------------------------------------
@@ -0,0 +1,99 @@
+package mlrval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalJSONScalars(t *testing.T) {
+	s, err := FromInt(6).MarshalJSON(JSON_SINGLE_LINE, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "6", s)
+
+	s, err = FromFloat(6.0).MarshalJSON(JSON_SINGLE_LINE, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "6.0", s)
+
+	s, err = FromFloat(-2.0).MarshalJSON(JSON_SINGLE_LINE, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "-2.0", s)
+
+	// The same, after the string representation has been computed and cached
+	computed := FromFloat(6.0)
+	assert.Equal(t, "6", computed.String())
+	s, err = computed.MarshalJSON(JSON_SINGLE_LINE, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "6.0", s)
+
+	s, err = FromFloat(7.25).MarshalJSON(JSON_SINGLE_LINE, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "7.25", s)
+
+	// Floats from input data keep their original formatting
+	s, err = FromInferredType("1.500").MarshalJSON(JSON_SINGLE_LINE, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "1.500", s)
+
+	s, err = FromInferredType("1e5").MarshalJSON(JSON_SINGLE_LINE, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "1e5", s)
+
+	// Including ints read as floats, as with mlr -A
+	s, err = FromPrevalidatedFloatString("123", 123.0).MarshalJSON(JSON_SINGLE_LINE, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "123", s)
+
+	// Floats formatted by fmtnum keep their formatting
+	formatter, err := GetFormatter("%.0f")
+	assert.Nil(t, err)
+	s, err = formatter.Format(FromFloat(3.7)).MarshalJSON(JSON_SINGLE_LINE, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "4", s)
+}
+
+func TestMarshalJSONRoundTrip(t *testing.T) {
+	inputs := []*Mlrval{
+		FromInt(6),
+		FromFloat(6.0),
+		FromString("123"),
+		FromBool(true),
+	}
+	for _, input := range inputs {
+		s, err := input.MarshalJSON(JSON_SINGLE_LINE, false)
+		assert.Nil(t, err)
+		output, err := TryUnmarshalJSON([]byte(s))
+		assert.Nil(t, err)
+		assert.Equal(t, input.Type(), output.Type())
+	}
+}
+
+func TestMarshalJSONFloatsAfterString(t *testing.T) {
+	formatter, err := GetFormatter("%.0f")
+	assert.Nil(t, err)
+
+	// An int read as float, as with mlr -A
+	SetInferrerIntAsFloat()
+	defer SetInferNormally()
+
+	computed := FromFloat(6.0)
+	fromData := FromInferredType("6")
+	formatted := formatter.Format(FromFloat(6.2))
+
+	for _, mv := range []*Mlrval{computed, fromData, formatted} {
+		assert.True(t, mv.IsFloat())
+		assert.Equal(t, "6", mv.String())
+	}
+
+	s, err := computed.MarshalJSON(JSON_SINGLE_LINE, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "6.0", s)
+
+	s, err = fromData.MarshalJSON(JSON_SINGLE_LINE, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "6", s)
+
+	s, err = formatted.MarshalJSON(JSON_SINGLE_LINE, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "6", s)
+}
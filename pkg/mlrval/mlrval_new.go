@@ -197,6 +197,18 @@ func FromInt(input int64) *Mlrval {
 	}
 }
 
+// SetFromInt is for in-place arithmetic, e.g. into an evaluator's scratch
+// mlrval, to avoid an allocation.
+func (mv *Mlrval) SetFromInt(input int64) *Mlrval {
+	mv.printrep = ""
+	mv.printrepValid = false
+	mv.intf = input
+	mv.err = nil
+	mv.mvtype = MT_INT
+	mv.floatOrigin = floatFromData
+	return mv
+}
+
 func FromIntShowingOctal(input int64) *Mlrval {
 	return &Mlrval{
 		mvtype:        MT_INT,
@@ -248,9 +260,22 @@ func FromFloat(input float64) *Mlrval {
 		mvtype:        MT_FLOAT,
 		printrepValid: false,
 		intf:          input,
+		floatOrigin:   floatComputed,
 	}
 }
 
+// SetFromFloat is for in-place arithmetic, e.g. into an evaluator's scratch
+// mlrval, to avoid an allocation.
+func (mv *Mlrval) SetFromFloat(input float64) *Mlrval {
+	mv.printrep = ""
+	mv.printrepValid = false
+	mv.intf = input
+	mv.err = nil
+	mv.mvtype = MT_FLOAT
+	mv.floatOrigin = floatComputed
+	return mv
+}
+
 // TryFromFloatString is used by the mlrval Formatter (fmtnum DSL function,
 // format-values verb, etc).  Each mlrval has printrep and a printrepValid for
 // its original string, then a type-code like MT_INT or MT_FLOAT, and
@@ -278,6 +303,7 @@ func (mv *Mlrval) SetFromPrevalidatedFloatString(input string, floatval float64)
 	mv.printrepValid = true
 	mv.intf = floatval
 	mv.mvtype = MT_FLOAT
+	mv.floatOrigin = floatFromData
 	return mv
 }
 
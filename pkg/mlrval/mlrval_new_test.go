@@ -74,6 +74,14 @@ func TestFromInt(t *testing.T) {
 	assert.False(t, mv.printrepValid, "printrep should not be computed yet")
 }
 
+func TestSetFromInt(t *testing.T) {
+	mv := FromString("abc")
+	mv.SetFromInt(123)
+	assert.Equal(t, MT_INT, mv.mvtype)
+	assert.False(t, mv.printrepValid, "printrep should not be computed yet")
+	assert.Equal(t, "123", mv.String())
+}
+
 func TestTryFromIntString(t *testing.T) {
 	mv := TryFromIntString("123")
 	assert.Equal(t, MT_INT, mv.mvtype)
@@ -90,6 +98,14 @@ func TestFromFloat(t *testing.T) {
 	assert.False(t, mv.printrepValid, "printrep should not be computed yet")
 }
 
+func TestSetFromFloat(t *testing.T) {
+	mv := FromInt(7)
+	mv.SetFromFloat(123.5)
+	assert.Equal(t, MT_FLOAT, mv.mvtype)
+	assert.False(t, mv.printrepValid, "printrep should not be computed yet")
+	assert.Equal(t, "123.5", mv.String())
+}
+
 func TestTryFromFloatString(t *testing.T) {
 	mv := TryFromFloatString("123.4")
 	assert.Equal(t, MT_FLOAT, mv.mvtype)
@@ -17,9 +17,10 @@ func (mv *Mlrval) String() string {
 	// if mv.IsFloat() && floatOutputFormatter != nil
 	// if mv.mvtype == MT_FLOAT && floatOutputFormatter != nil {
 	//if floatOutputFormatter != nil && (mv.mvtype == MT_FLOAT || mv.mvtype == MT_PENDING) {
-	if floatOutputFormatter != nil && mv.Type() == MT_FLOAT {
-		// Use the format string from global --ofmt, if supplied
-		return floatOutputFormatter.FormatFloat(mv.intf.(float64))
+	if floatOutputFormatter != nil && mv.Type() == MT_FLOAT && mv.floatOrigin != floatFormatted {
+		// Use the format string from global --ofmt, if supplied, unless the
+		// value was already formatted by fmtnum or the like
+		return FormatFloat(mv.intf.(float64), floatOutputFormatter)
 	}
 
 	// TODO: track dirty-flag checking / somesuch.
@@ -84,7 +85,7 @@ func (mv *Mlrval) setPrintRep() {
 			mv.printrep = strconv.FormatInt(mv.intf.(int64), 10)
 
 		case MT_FLOAT:
-			mv.printrep = strconv.FormatFloat(mv.intf.(float64), 'f', -1, 64)
+			mv.printrep = FormatFloat(mv.intf.(float64), nil)
 
 		case MT_BOOL:
 			if mv.intf.(bool) == true {
@@ -134,6 +135,27 @@ func (mv *Mlrval) StringifyValuesRecursively() {
 	}
 }
 
+// StringifyOriginalValuesRecursively is like StringifyValuesRecursively, but
+// leaves out --ofmt formatting so that values read from data keep their
+// original text. This is for put/filter -S.
+func (mv *Mlrval) StringifyOriginalValuesRecursively() {
+	switch mv.mvtype {
+
+	case MT_ARRAY:
+		for _, element := range mv.intf.([]*Mlrval) {
+			element.StringifyOriginalValuesRecursively()
+		}
+
+	case MT_MAP:
+		for pe := mv.intf.(*Mlrmap).Head; pe != nil; pe = pe.Next {
+			pe.Value.StringifyOriginalValuesRecursively()
+		}
+
+	default:
+		mv.SetFromString(mv.OriginalString())
+	}
+}
+
 func (mv *Mlrval) ShowSizes() {
 	fmt.Printf("TOTAL            %p %d\n", mv, reflect.TypeOf(*mv).Size())
 	//fmt.Printf("mv.intf          %p %d\n", &mv.intf, reflect.TypeOf(mv.intf).Size())
@@ -16,3 +16,74 @@ func TestString(t *testing.T) {
 	assert.Equal(t, "", FromInferredType("").String())
 	assert.Equal(t, "", FromDeferredType("").String())
 }
+
+func TestFloatRoundTrip(t *testing.T) {
+	// Unmodified values from data keep their original text
+	for _, input := range []string{"1.10", "1e3", "0.30000000000000004", "-0.0", "1_000.5"} {
+		mv := FromInferredType(input)
+		assert.Equal(t, input, mv.String())
+		assert.Equal(t, input, mv.Copy().String())
+		assert.Equal(t, input, FromDeferredType(input).OriginalString())
+	}
+	assert.True(t, FromInferredType("1.10").IsFloat())
+	assert.True(t, FromInferredType("1e3").IsFloat())
+
+	// Computed values use the shortest representation which round-trips
+	assert.Equal(t, "1.1", FromFloat(1.10).String())
+	tenth, fifth := 0.1, 0.2
+	assert.Equal(t, "0.30000000000000004", FromFloat(tenth+fifth).String())
+	assert.Equal(t, "1000", FromFloat(1e3).String())
+}
+
+func TestStringWithOFMT(t *testing.T) {
+	err := SetFloatOutputFormat("%.3f")
+	assert.Nil(t, err)
+	defer func() { floatOutputFormatter = nil }()
+
+	assert.Equal(t, "234", FromInferredType("234").String())
+	assert.Equal(t, "0xff", FromInferredType("0xff").String())
+	assert.Equal(t, "abc", FromInferredType("abc").String())
+	assert.Equal(t, "234.568", FromInferredType("234.5678").String())
+	assert.Equal(t, "234.568", FromDeferredType("234.5678").String())
+	assert.Equal(t, "0.333", FromFloat(1.0/3.0).String())
+
+	formatter, err := GetFormatter("%.5f")
+	assert.Nil(t, err)
+	formatted := formatter.Format(FromFloat(1.0 / 3.0))
+	assert.Equal(t, "0.33333", formatted.String())
+	assert.Equal(t, "0.33333", formatted.Copy().String())
+	formatted.SetFromPrevalidatedFloatString("0.25", 0.25)
+	assert.Equal(t, "0.250", formatted.String())
+}
+
+func TestStringifyOriginalValuesRecursively(t *testing.T) {
+	err := SetFloatOutputFormat("%.3f")
+	assert.Nil(t, err)
+	defer func() { floatOutputFormatter = nil }()
+
+	mv := FromDeferredType("234.5678")
+	mv.StringifyOriginalValuesRecursively()
+	assert.True(t, mv.IsStringOrVoid())
+	assert.Equal(t, "234.5678", mv.String())
+
+	mv = FromDeferredType("")
+	mv.StringifyOriginalValuesRecursively()
+	assert.True(t, mv.IsVoid())
+
+	mlrmap := NewMlrmap()
+	mlrmap.PutReference("a", FromDeferredType("0x10"))
+	mlrmap.PutReference("b", FromArray([]*Mlrval{FromDeferredType("1.50"), FromBool(true)}))
+	mv = FromMap(mlrmap)
+	mv.StringifyOriginalValuesRecursively()
+	stringified := mv.GetMap()
+	assert.Equal(t, MT_STRING, stringified.Get("a").Type())
+	assert.Equal(t, "0x10", stringified.Get("a").String())
+	elements := stringified.Get("b").GetArray()
+	assert.Equal(t, MT_STRING, elements[0].Type())
+	assert.Equal(t, "1.50", elements[0].String())
+	assert.Equal(t, MT_STRING, elements[1].Type())
+	assert.Equal(t, "true", elements[1].String())
+
+	// The original map was copied by FromMap and is unmodified
+	assert.Equal(t, MT_INT, mlrmap.Get("a").Type())
+}
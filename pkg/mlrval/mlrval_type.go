@@ -61,8 +61,27 @@ type Mlrval struct {
 	// Enumeration for string / int / float / boolean / etc.
 	// I would call this "type" not "mvtype" but "type" is a keyword in Go.
 	mvtype MVType
+	// For floats: where the value came from, which decides how it's written.
+	floatOrigin floatOrigin
 }
 
+// floatOrigin is where a float came from. This, not whether the printrep
+// happens to have been computed yet, decides its output formatting:
+//
+//   - Floats from input data keep their original formatting.
+//   - Computed floats, e.g. from 2.0 * 3, are formatted from their value; in
+//     JSON output, integral ones get a ".0" so they read back in as floats.
+//   - Floats formatted by fmtnum, format-values, etc. keep that formatting.
+//
+// The global --ofmt applies to the first two but not the third.
+type floatOrigin int8
+
+const (
+	floatFromData floatOrigin = iota
+	floatComputed
+	floatFormatted
+)
+
 const INVALID_PRINTREP = "(bug-if-you-see-this:case-2)"
 const ERROR_PRINTREP = "(error)"
 const ABSENT_PRINTREP = "(absent)"
@@ -104,7 +104,11 @@ func channelWriterHandleBatch(
 
 			outputString := recordAndContext.OutputString
 			if outputString != "" {
-				bufferedOutputStream.WriteString(outputString)
+				if outputStringWriter, ok := recordWriter.(IOutputStringWriter); ok {
+					outputStringWriter.WriteOutputString(outputString, bufferedOutputStream)
+				} else {
+					bufferedOutputStream.WriteString(outputString)
+				}
 			}
 
 			if writerOptions.FlushOnEveryRecord {
@@ -24,3 +24,12 @@ type IRecordWriter interface {
 		outputIsStdout bool,
 	) error
 }
+
+// IOutputStringWriter is for record-writers which leave the last line of
+// output unterminated between records, e.g. JSON with list-wrap which places
+// commas only once it knows another record follows. The ChannelWriter hands
+// put/filter print output to WriteOutputString rather than writing it directly,
+// so that the printed text can start on a line of its own after any comma.
+type IOutputStringWriter interface {
+	WriteOutputString(outputString string, bufferedOutputStream *bufio.Writer)
+}
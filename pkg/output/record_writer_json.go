@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/johnkerl/miller/pkg/cli"
 	"github.com/johnkerl/miller/pkg/mlrval"
@@ -19,6 +20,9 @@ type RecordWriterJSON struct {
 
 	// State:
 	wroteAnyRecords bool
+	// Print output since the last record, held back until we know whether a
+	// comma goes after that record
+	pendingOutput strings.Builder
 }
 
 // ----------------------------------------------------------------
@@ -76,6 +80,7 @@ func (writer *RecordWriterJSON) writeWithListWrap(
 
 		if writer.wroteAnyRecords {
 			bufferedOutputStream.WriteString(",\n")
+			writer.writePendingOutput(bufferedOutputStream)
 		}
 
 		bufferedOutputStream.WriteString(s)
@@ -90,12 +95,30 @@ func (writer *RecordWriterJSON) writeWithListWrap(
 				bufferedOutputStream.WriteString("\n]\n")
 			}
 		} else {
-			bufferedOutputStream.WriteString("\n]\n")
+			bufferedOutputStream.WriteString("\n")
+			writer.writePendingOutput(bufferedOutputStream)
+			bufferedOutputStream.WriteString("]\n")
 		}
 
 	}
 }
 
+// WriteOutputString implements IOutputStringWriter. With list-wrap, print
+// output after a record is held until the next record or the end of the
+// stream, so the comma separating records stays on the previous record's line.
+func (writer *RecordWriterJSON) WriteOutputString(outputString string, bufferedOutputStream *bufio.Writer) {
+	if writer.writerOptions.WrapJSONOutputInOuterList && writer.wroteAnyRecords {
+		writer.pendingOutput.WriteString(outputString)
+	} else {
+		bufferedOutputStream.WriteString(outputString)
+	}
+}
+
+func (writer *RecordWriterJSON) writePendingOutput(bufferedOutputStream *bufio.Writer) {
+	bufferedOutputStream.WriteString(writer.pendingOutput.String())
+	writer.pendingOutput.Reset()
+}
+
 // ----------------------------------------------------------------
 func (writer *RecordWriterJSON) writeWithoutListWrap(
 	outrec *mlrval.Mlrmap,
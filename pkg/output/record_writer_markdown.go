@@ -52,7 +52,8 @@ func (writer *RecordWriterMarkdown) Write(
 		bufferedOutputStream.WriteString("|")
 		for pe := outrec.Head; pe != nil; pe = pe.Next {
 			bufferedOutputStream.WriteString(" ")
-			bufferedOutputStream.WriteString(colorizer.MaybeColorizeKey(pe.Key, outputIsStdout))
+			key := strings.ReplaceAll(pe.Key, "|", "\\|")
+			bufferedOutputStream.WriteString(colorizer.MaybeColorizeKey(key, outputIsStdout))
 			bufferedOutputStream.WriteString(" |")
 		}
 		bufferedOutputStream.WriteString(writer.writerOptions.ORS)
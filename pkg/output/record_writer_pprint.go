@@ -260,8 +260,8 @@ func (writer *RecordWriterPPRINT) writeHeterogenousListBarred(
 	for e := records.Front(); e != nil; e = e.Next() {
 		outrec := e.Value.(*mlrval.Mlrmap)
 
-		// Print header line
-		if onFirst && !writer.writerOptions.HeaderlessOutput {
+		// Print top border, even if there is no header line beneath it
+		if onFirst {
 			bufferedOutputStream.WriteString(horizontalStart)
 			for pe := outrec.Head; pe != nil; pe = pe.Next {
 				bufferedOutputStream.WriteString(horizontalBars[pe.Key])
@@ -272,7 +272,10 @@ func (writer *RecordWriterPPRINT) writeHeterogenousListBarred(
 					bufferedOutputStream.WriteString(writer.writerOptions.ORS)
 				}
 			}
+		}
 
+		// Print header line
+		if onFirst && !writer.writerOptions.HeaderlessOutput {
 			bufferedOutputStream.WriteString(verticalStart)
 			for pe := outrec.Head; pe != nil; pe = pe.Next {
 				if !writer.writerOptions.RightAlignedPPRINTOutput { // left-align
@@ -307,6 +310,9 @@ func (writer *RecordWriterPPRINT) writeHeterogenousListBarred(
 		bufferedOutputStream.WriteString(verticalStart)
 		for pe := outrec.Head; pe != nil; pe = pe.Next {
 			s := pe.Value.String()
+			if s == "" {
+				s = "-"
+			}
 			if !writer.writerOptions.RightAlignedPPRINTOutput { // left-align
 				bufferedOutputStream.WriteString(colorizer.MaybeColorizeValue(s, outputIsStdout))
 				writer.writePadding(s, maxWidths[pe.Key], bufferedOutputStream)
@@ -43,7 +43,8 @@ THE SOFTWARE.
 // supported specifier (i.e. there must be intervening text to match first)
 
 // Local mods (johnkerl 2021-10-17): ParseTZ and strptime_tz supporting
-// Miller's idiosyncrasies.
+// Miller's idiosyncrasies. Also, fractional seconds are accepted after %S even
+// when it's the last thing in the format string.
 
 package strptime
 
@@ -261,6 +262,11 @@ func strptime_tz(
 						}
 						return time.Time{}, ErrFormatMismatch
 					}
+					if formatCode == 'S' {
+						// E.g. "%S" at the end of the format with input "05.25": time.Parse
+						// accepts fractional seconds after the seconds field, so keep them.
+						sil += fractionalSecondsLength(strptime_input[sii+sil:])
+					}
 				}
 			}
 
@@ -318,6 +324,22 @@ func strptime_tz(
 	}
 }
 
+// fractionalSecondsLength returns the length of the leading ".ddd" in the
+// input, if any, else 0.
+func fractionalSecondsLength(input string) int {
+	if len(input) < 2 || input[0] != '.' {
+		return 0
+	}
+	n := 1
+	for n < len(input) && input[n] >= '0' && input[n] <= '9' {
+		n++
+	}
+	if n == 1 {
+		return 0
+	}
+	return n
+}
+
 // expandShorthands handles some shorthands that the C library uses, which we can easily
 // replicate -- e.g. "%F" is "%Y-%m-%d".
 func expandShorthands(format string) string {
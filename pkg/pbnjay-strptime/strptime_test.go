@@ -77,3 +77,26 @@ func TestStrptime(t *testing.T) {
 		}
 	}
 }
+
+func TestStrptimeFractionalSeconds(t *testing.T) {
+	cases := []struct {
+		input  string
+		format string
+		nanos  int64
+	}{
+		{"1970-01-01T00:00:01.25Z", "%Y-%m-%dT%H:%M:%SZ", 1250000000},
+		{"1970-01-01 00:00:01.25", "%Y-%m-%d %H:%M:%S", 1250000000},
+		{"1970-01-01 00:00:01.123456789", "%Y-%m-%d %H:%M:%S", 1123456789},
+		{"1970-01-01 00:00:01", "%Y-%m-%d %H:%M:%S", 1000000000},
+		{"19700101000001.5", "%Y%m%d%H%M%S", 1500000000},
+	}
+	for _, c := range cases {
+		tval, err := Parse(c.input, c.format)
+		assert.Nil(t, err, c.input)
+		assert.Equal(t, c.nanos, tval.UnixNano(), c.input)
+	}
+
+	// A trailing dot with no digits is not a fractional part
+	_, err := Parse("1970-01-01 00:00:01.", "%Y-%m-%d %H:%M:%S")
+	assert.NotNil(t, err)
+}
@@ -86,10 +86,23 @@ func NewStack() *Stack {
 	}
 }
 
-// For when a user-defined function/subroutine is being entered
-func (stack *Stack) PushStackFrameSet() {
+// MaxStackFrameSetDepth bounds the nesting of user-defined function and
+// subroutine calls, so that runaway recursion in a DSL expression is reported
+// as an error rather than crashing the Go runtime with a stack overflow.
+const MaxStackFrameSetDepth = 10000
+
+// For when a user-defined function/subroutine is being entered. The caller
+// must not call PopStackFrameSet if this returns an error.
+func (stack *Stack) PushStackFrameSet() error {
+	if stack.stackFrameSets.Len() > MaxStackFrameSetDepth {
+		return fmt.Errorf(
+			"mlr: maximum function-call depth %d exceeded; is there a recursion with no base case?",
+			MaxStackFrameSetDepth,
+		)
+	}
 	stack.head = newStackFrameSet()
 	stack.stackFrameSets.PushFront(stack.head)
+	return nil
 }
 
 // For when a user-defined function/subroutine is being exited
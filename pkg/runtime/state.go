@@ -41,6 +41,10 @@ type State struct {
 
 	// StrictMode allows for runtime handling of absent-reads and untyped assignments.
 	StrictMode bool
+
+	// StringFieldValues is for put/filter -S: field values are read as
+	// strings, without type inference.
+	StringFieldValues bool
 }
 
 func NewEmptyState(options *cli.TOptions, strictMode bool) *State {
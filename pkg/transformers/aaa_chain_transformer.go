@@ -206,6 +206,15 @@ func runSingleTransformer(
 	options *cli.TOptions,
 ) {
 
+	if flushable, ok := recordTransformer.(IOutputFlushable); ok {
+		flushable.SetOutputFlusher(func(outputRecordsAndContexts *list.List) {
+			batch := list.New()
+			batch.PushBackList(outputRecordsAndContexts)
+			outputRecordsAndContexts.Init()
+			outputRecordChannel <- batch
+		})
+	}
+
 	done := false
 	for !done {
 		recordsAndContexts := <-inputRecordChannel
@@ -221,8 +230,10 @@ func runSingleTransformer(
 	}
 }
 
-// TODO: comment
-// Returns true on end of record stream
+// runSingleTransformerBatch passes one batch of records, as sized by
+// --records-per-batch, through the transformer, and sends whatever the
+// transformer produced downstream as a single batch. Returns true on end of
+// record stream.
 func runSingleTransformerBatch(
 	inputRecordsAndContexts *list.List, // list of types.RecordAndContext
 	recordTransformer IRecordTransformer,
@@ -288,7 +299,11 @@ func runSingleTransformerBatch(
 		}
 	}
 
-	outputRecordChannel <- outputRecordsAndContexts
+	// Verbs such as sort and tac produce nothing until end of stream; don't
+	// spend a channel send on each empty batch.
+	if done || outputRecordsAndContexts.Len() > 0 {
+		outputRecordChannel <- outputRecordsAndContexts
+	}
 
 	return done
 }
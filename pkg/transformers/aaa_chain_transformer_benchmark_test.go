@@ -0,0 +1,82 @@
+package transformers
+
+import (
+	"container/list"
+	"testing"
+
+	"github.com/johnkerl/miller/pkg/cli"
+	"github.com/johnkerl/miller/pkg/mlrval"
+	"github.com/johnkerl/miller/pkg/types"
+)
+
+// go test -run=nonesuch -bench=. github.com/johnkerl/miller/pkg/transformers/...
+//
+// These compare the per-record cost of the transformer chain for small and
+// large --records-per-batch settings: the work per record is the same, but
+// with larger batches there are far fewer channel operations.
+
+const benchmarkRecordCount = 100000
+
+func BenchmarkChainTransformerBatchSize1(b *testing.B) {
+	benchmarkChainTransformer(b, 1)
+}
+
+func BenchmarkChainTransformerBatchSize500(b *testing.B) {
+	benchmarkChainTransformer(b, 500)
+}
+
+func benchmarkChainTransformer(b *testing.B, recordsPerBatch int) {
+	options := cli.DefaultOptions()
+	context := types.NewNilContext()
+	record := mlrval.NewMlrmapAsRecord()
+	record.PutCopy("a", mlrval.FromString("pan"))
+	record.PutCopy("i", mlrval.FromInt(1))
+	record.PutCopy("x", mlrval.FromFloat(0.3467901443380824))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		recordTransformers := make([]IRecordTransformer, 3)
+		for j := range recordTransformers {
+			recordTransformer, err := NewTransformerCat(false, "", nil, false, false)
+			if err != nil {
+				b.Fatal(err)
+			}
+			recordTransformers[j] = recordTransformer
+		}
+
+		readerChannel := make(chan *list.List, 2)
+		readerDownstreamDoneChannel := make(chan bool, 1)
+		writerChannel := make(chan *list.List, 2)
+		ChainTransformer(readerChannel, readerDownstreamDoneChannel, recordTransformers, writerChannel, options)
+
+		go func() {
+			batch := list.New()
+			for n := 0; n < benchmarkRecordCount; n++ {
+				batch.PushBack(types.NewRecordAndContext(record, context))
+				if batch.Len() >= recordsPerBatch {
+					readerChannel <- batch
+					batch = list.New()
+				}
+			}
+			batch.PushBack(types.NewEndOfStreamMarker(context))
+			readerChannel <- batch
+		}()
+
+		count := 0
+		done := false
+		for !done {
+			batch := <-writerChannel
+			for e := batch.Front(); e != nil; e = e.Next() {
+				recordAndContext := e.Value.(*types.RecordAndContext)
+				if recordAndContext.EndOfStream {
+					done = true
+				} else if recordAndContext.Record != nil {
+					count++
+				}
+			}
+		}
+		if count != benchmarkRecordCount {
+			b.Fatalf("expected %d records; got %d", benchmarkRecordCount, count)
+		}
+	}
+}
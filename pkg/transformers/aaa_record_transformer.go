@@ -20,6 +20,15 @@ type IRecordTransformer interface {
 	)
 }
 
+// IOutputFlushable is optionally satisfied by transformers which may need to
+// emit more records at once than are reasonable to hold in memory, e.g. tac
+// when spilling to disk. The chain transformer provides a flush function which
+// sends the records accumulated so far in the output list downstream, and
+// empties the list.
+type IOutputFlushable interface {
+	SetOutputFlusher(flush func(outputRecordsAndContexts *list.List))
+}
+
 type RecordTransformerFunc func(
 	inrecAndContext *types.RecordAndContext,
 	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
@@ -30,6 +30,7 @@ var TRANSFORMER_LOOKUP_TABLE = []TransformerSetup{
 	FormatValuesSetup,
 	FractionSetup,
 	GapSetup,
+	GMT2SecSetup,
 	GrepSetup,
 	GroupBySetup,
 	GroupLikeSetup,
@@ -27,7 +27,10 @@ func transformerAltkvUsage(
 ) {
 	fmt.Fprintf(o, "Usage: %s %s [options]\n", "mlr", verbNameAltkv)
 	fmt.Fprintf(o, "Given fields with values of the form a,b,c,d,e,f emits a=b,c=d,e=f pairs.\n")
+	fmt.Fprintf(o, "If there are an odd number of values, the last one is given its output field\n")
+	fmt.Fprintf(o, "number as key: e.g. a,b,c,d,e,f,g emits a=b,c=d,e=f,4=g.\n")
 	fmt.Fprintf(o, "Options:\n")
+	fmt.Fprintf(o, "--drop-odd Drop the last value when there are an odd number of values.\n")
 	fmt.Fprintf(o, "-h|--help Show this message.\n")
 }
 
@@ -43,6 +46,8 @@ func transformerAltkvParseCLI(
 	argi := *pargi
 	argi++
 
+	dropOdd := false
+
 	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
 		opt := args[argi]
 		if !strings.HasPrefix(opt, "-") {
@@ -57,6 +62,9 @@ func transformerAltkvParseCLI(
 			transformerAltkvUsage(os.Stdout)
 			os.Exit(0)
 
+		} else if opt == "--drop-odd" {
+			dropOdd = true
+
 		} else {
 			transformerAltkvUsage(os.Stderr)
 			os.Exit(1)
@@ -68,7 +76,7 @@ func transformerAltkvParseCLI(
 		return nil
 	}
 
-	transformer, err := NewTransformerAltkv()
+	transformer, err := NewTransformerAltkv(dropOdd)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
@@ -79,10 +87,15 @@ func transformerAltkvParseCLI(
 
 // ----------------------------------------------------------------
 type TransformerAltkv struct {
+	dropOdd bool
 }
 
-func NewTransformerAltkv() (*TransformerAltkv, error) {
-	tr := &TransformerAltkv{}
+func NewTransformerAltkv(
+	dropOdd bool,
+) (*TransformerAltkv, error) {
+	tr := &TransformerAltkv{
+		dropOdd: dropOdd,
+	}
 	return tr, nil
 }
 
@@ -106,7 +119,7 @@ func (tr *TransformerAltkv) Transform(
 				value := pe.Next.Value
 				// Transferring ownership from old record to new record; no copy needed
 				newrec.PutReference(key, value)
-			} else { // At end of record with odd-numbered field count
+			} else if !tr.dropOdd { // At end of record with odd-numbered field count
 				key := strconv.Itoa(outputFieldNumber)
 				value := pe.Value
 				// Transferring ownership from old record to new record; no copy needed
@@ -47,6 +47,9 @@ func transformerBarUsage(
 	fmt.Fprintf(o, "-b   {character}  Blank character: default '%s'.\n", barDefaultBlankString)
 	fmt.Fprintf(o, "Nominally the fill, out-of-bounds, and blank characters will be strings of length 1.\n")
 	fmt.Fprintf(o, "However you can make them all longer if you so desire.\n")
+	fmt.Fprintf(o, "Values at or below the lower limit are shown with the out-of-bounds character\n")
+	fmt.Fprintf(o, "at the left end of the bar; values at or above the upper limit, at the right end.\n")
+	fmt.Fprintf(o, "With --auto, if all values are the same, their bars are drawn half-full.\n")
 	fmt.Fprintf(o, "-h|--help Show this message.\n")
 }
 
@@ -167,6 +170,12 @@ func NewTransformerBar(
 	oobString string,
 	blankString string,
 ) (*TransformerBar, error) {
+	if width < 1 {
+		return nil, fmt.Errorf("mlr %s: -w must be positive; got %d.", verbNameBar, width)
+	}
+	if !doAuto && lo == hi {
+		return nil, fmt.Errorf("mlr %s: --lo and --hi must differ; got %g for both.", verbNameBar, lo)
+	}
 
 	tr := &TransformerBar{
 		fieldNames:  fieldNames,
@@ -234,13 +243,7 @@ func (tr *TransformerBar) processNoAuto(
 			if !ok {
 				continue
 			}
-			idx := int(float64(tr.width) * (floatValue - tr.lo) / (tr.hi - tr.lo))
-			if idx < 0 {
-				idx = 0
-			}
-			if idx > tr.width {
-				idx = tr.width
-			}
+			idx := tr.barIndex(floatValue, tr.lo, tr.hi)
 			inrec.PutReference(fieldName, mlrval.FromString(tr.bars[idx]))
 		}
 
@@ -313,13 +316,7 @@ func (tr *TransformerBar) processAuto(
 				continue
 			}
 
-			idx := int((float64(tr.width) * (floatValue - lo) / (hi - lo)))
-			if idx < 0 {
-				idx = 0
-			}
-			if idx > tr.width {
-				idx = tr.width
-			}
+			idx := tr.barIndex(floatValue, lo, hi)
 
 			var buffer bytes.Buffer
 			buffer.WriteString("[")
@@ -340,3 +337,22 @@ func (tr *TransformerBar) processAuto(
 
 	outputRecordsAndContexts.PushBack(inrecAndContext) // Emit the end-of-stream marker
 }
+
+// barIndex maps a value to an index into tr.bars. Index 0 is the
+// out-of-bounds-low bar and index tr.width is the out-of-bounds-high bar; since
+// the scaled value is truncated, lo itself maps to 0 and hi maps to tr.width.
+// With --auto, lo and hi are equal when all the values are; then everything is
+// shown at the midpoint.
+func (tr *TransformerBar) barIndex(floatValue, lo, hi float64) int {
+	if lo == hi {
+		return tr.width / 2
+	}
+	idx := int(float64(tr.width) * (floatValue - lo) / (hi - lo))
+	if idx < 0 {
+		idx = 0
+	}
+	if idx > tr.width {
+		idx = tr.width
+	}
+	return idx
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/johnkerl/miller/pkg/cli"
+	"github.com/johnkerl/miller/pkg/lib"
 	"github.com/johnkerl/miller/pkg/types"
 )
 
@@ -24,13 +25,16 @@ func transformerCheckUsage(
 	o *os.File,
 ) {
 	fmt.Fprintf(o, "Usage: %s %s [options]\n", "mlr", verbNameCheck)
-	fmt.Fprintf(o, "Consumes records without printing any output,\n")
+	fmt.Fprintf(o, "Consumes records without printing any output.\n")
 	fmt.Fprintf(o, "Useful for doing a well-formatted check on input data.\n")
-	fmt.Fprintf(o, "with the exception that warnings are printed to stderr.\n")
+	fmt.Fprintf(o, "Warnings, and a summary of record and field counts, are printed to stderr.\n")
+	fmt.Fprintf(o, "Exits nonzero if the input could not be parsed.\n")
 	fmt.Fprintf(o, "Current checks are:\n")
 	fmt.Fprintf(o, "* Data are parseable\n")
 	fmt.Fprintf(o, "* If any key is the empty string\n")
 	fmt.Fprintf(o, "Options:\n")
+	fmt.Fprintf(o, "-v|--verbose Also print record counts for each distinct schema (list of\n")
+	fmt.Fprintf(o, "             field names), in order of first appearance.\n")
 	fmt.Fprintf(o, "-h|--help Show this message.\n")
 }
 
@@ -46,6 +50,8 @@ func transformerCheckParseCLI(
 	argi := *pargi
 	argi++
 
+	verbose := false
+
 	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
 		opt := args[argi]
 		if !strings.HasPrefix(opt, "-") {
@@ -60,6 +66,9 @@ func transformerCheckParseCLI(
 			transformerCheckUsage(os.Stdout)
 			os.Exit(0)
 
+		} else if opt == "-v" || opt == "--verbose" {
+			verbose = true
+
 		} else {
 			transformerCheckUsage(os.Stderr)
 			os.Exit(1)
@@ -71,7 +80,7 @@ func transformerCheckParseCLI(
 		return nil
 	}
 
-	transformer, err := NewTransformerCheck()
+	transformer, err := NewTransformerCheck(verbose)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
@@ -82,13 +91,20 @@ func transformerCheckParseCLI(
 
 // ----------------------------------------------------------------
 type TransformerCheck struct {
-	// stateless
+	verbose bool
+
 	messagedReEmptyKey map[string]bool
+	recordCount        int64
+	fieldCount         int64
+	// Schema (comma-joined field names) to record count, if verbose
+	countsBySchema *lib.OrderedMap
 }
 
-func NewTransformerCheck() (*TransformerCheck, error) {
+func NewTransformerCheck(verbose bool) (*TransformerCheck, error) {
 	return &TransformerCheck{
+		verbose:            verbose,
 		messagedReEmptyKey: make(map[string]bool),
+		countsBySchema:     lib.NewOrderedMap(),
 	}, nil
 }
 
@@ -101,6 +117,17 @@ func (tr *TransformerCheck) Transform(
 	HandleDefaultDownstreamDone(inputDownstreamDoneChannel, outputDownstreamDoneChannel)
 	if !inrecAndContext.EndOfStream {
 		inrec := inrecAndContext.Record
+		tr.recordCount++
+		tr.fieldCount += inrec.FieldCount
+		if tr.verbose {
+			schema := inrec.GetKeysJoined()
+			count, present := tr.countsBySchema.GetWithCheck(schema)
+			if present {
+				tr.countsBySchema.Put(schema, count.(int64)+1)
+			} else {
+				tr.countsBySchema.Put(schema, int64(1))
+			}
+		}
 		for pe := inrec.Head; pe != nil; pe = pe.Next {
 			if pe.Key == "" {
 				context := inrecAndContext.Context
@@ -120,6 +147,16 @@ func (tr *TransformerCheck) Transform(
 			}
 		}
 	} else {
+		tr.printSummary()
 		outputRecordsAndContexts.PushBack(inrecAndContext)
 	}
 }
+
+func (tr *TransformerCheck) printSummary() {
+	fmt.Fprintf(os.Stderr, "mlr check: %d records, %d fields\n", tr.recordCount, tr.fieldCount)
+	if tr.verbose {
+		for pe := tr.countsBySchema.Head; pe != nil; pe = pe.Next {
+			fmt.Fprintf(os.Stderr, "mlr check: %d records with fields %s\n", pe.Value.(int64), pe.Key)
+		}
+	}
+}
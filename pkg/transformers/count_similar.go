@@ -27,7 +27,10 @@ func transformerCountSimilarUsage(
 ) {
 	fmt.Fprintf(o, "Usage: %s %s [options]\n", "mlr", verbNameCountSimilar)
 	fmt.Fprintf(o, "Ingests all records, then emits each record augmented by a count of\n")
-	fmt.Fprintf(o, "the number of other records having the same group-by field values.\n")
+	fmt.Fprintf(o, "the number of records, including itself, having the same group-by field values.\n")
+	fmt.Fprintf(o, "Records are emitted grouped together, with groups in order of first appearance\n")
+	fmt.Fprintf(o, "and records within each group in input order. Records lacking any of the\n")
+	fmt.Fprintf(o, "group-by fields are not emitted.\n")
 	fmt.Fprintf(o, "Options:\n")
 	fmt.Fprintf(o, "-g {a,b,c} Group-by-field names for counts, e.g. a,b,c\n")
 	fmt.Fprintf(o, "-o {name} Field name for output-counts. Defaults to \"count\".\n")
@@ -150,7 +153,6 @@ func (tr *TransformerCountSimilar) Transform(
 
 		for outer := tr.recordListsByGroup.Head; outer != nil; outer = outer.Next {
 			recordListForGroup := outer.Value.(*list.List)
-			// TODO: make 64-bit friendly
 			groupSize := recordListForGroup.Len()
 			mgroupSize := mlrval.FromInt(int64(groupSize))
 			for inner := recordListForGroup.Front(); inner != nil; inner = inner.Next() {
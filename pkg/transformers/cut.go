@@ -36,7 +36,11 @@ func transformerCutUsage(
 	fmt.Fprintf(o, " -r Treat field names as regular expressions. \"ab\", \"a.*b\" will\n")
 	fmt.Fprintf(o, "   match any field name containing the substring \"ab\" or matching\n")
 	fmt.Fprintf(o, "   \"a.*b\", respectively; anchors of the form \"^ab$\", \"^a.*b$\" may\n")
-	fmt.Fprintf(o, "   be used. The -o flag is ignored when -r is present.\n")
+	fmt.Fprintf(o, "   be used. A regex in double quotes with a trailing i, as in \"ab\"i, is\n")
+	fmt.Fprintf(o, "   case-insensitive. The -o flag is ignored when -r is present.\n")
+	fmt.Fprintf(o, "Without -r, entries in double quotes are regexes as above and the rest are\n")
+	fmt.Fprintf(o, "literal field names, so the two may be mixed in one -f list. The -o flag is\n")
+	fmt.Fprintf(o, "ignored when any entry is quoted.\n")
 	fmt.Fprintf(o, "-h|--help Show this message.\n")
 	fmt.Fprintf(o, "Examples:\n")
 	fmt.Fprintf(o, "  %s %s -f hostname,status\n", "mlr", verbNameCut)
@@ -44,6 +48,7 @@ func transformerCutUsage(
 	fmt.Fprintf(o, "  %s %s -r -f '^status$,sda[0-9]'\n", "mlr", verbNameCut)
 	fmt.Fprintf(o, "  %s %s -r -f '^status$,\"sda[0-9]\"'\n", "mlr", verbNameCut)
 	fmt.Fprintf(o, "  %s %s -r -f '^status$,\"sda[0-9]\"i' (this is case-insensitive)\n", "mlr", verbNameCut)
+	fmt.Fprintf(o, "  %s %s -f 'hostname,\"^sda[0-9]\"i' (literal hostname, case-insensitive regex)\n", "mlr", verbNameCut)
 }
 
 func transformerCutParseCLI(
@@ -143,7 +148,19 @@ func NewTransformerCut(
 
 	tr := &TransformerCut{}
 
+	// Without -r, quoted entries like "^sda[0-9]"i are still regexes, and the
+	// rest are literal field names.
+	hasQuotedRegexes := false
 	if !doRegexes {
+		for _, fieldName := range fieldNames {
+			if lib.IsQuotedMillerRegex(fieldName) {
+				hasQuotedRegexes = true
+				break
+			}
+		}
+	}
+
+	if !doRegexes && !hasQuotedRegexes {
 		tr.fieldNameList = fieldNames
 		tr.fieldNameSet = lib.StringListToSet(fieldNames)
 		if !doComplement {
@@ -159,6 +176,9 @@ func NewTransformerCut(
 		tr.doComplement = doComplement
 		tr.regexes = make([]*regexp.Regexp, len(fieldNames))
 		for i, regexString := range fieldNames {
+			if !doRegexes && !lib.IsQuotedMillerRegex(regexString) {
+				regexString = "^" + regexp.QuoteMeta(regexString) + "$"
+			}
 			// Handles "a.*b"i Miller case-insensitive-regex specification
 			regex, err := lib.CompileMillerRegex(regexString)
 			if err != nil {
@@ -8,6 +8,7 @@ import (
 
 	"github.com/johnkerl/miller/pkg/cli"
 	"github.com/johnkerl/miller/pkg/lib"
+	"github.com/johnkerl/miller/pkg/mlrval"
 	"github.com/johnkerl/miller/pkg/types"
 )
 
@@ -27,10 +28,14 @@ func transformerFlattenUsage(
 	fmt.Fprintf(o, "Usage: %s %s [options]\n", "mlr", verbNameFlatten)
 	fmt.Fprint(o,
 		`Flattens multi-level maps to single-level ones. Example: field with name 'a'
-and value '{"b": { "c": 4 }}' becomes name 'a.b.c' and value 4.
+and value '{"b": { "c": 4 }}' becomes name 'a.b.c' and value 4. Arrays are
+flattened using 1-up indices: field 'x' with value '[7, [8, 9]]' becomes fields
+'x.1', 'x.2.1', and 'x.2.2'. Empty maps and arrays become "{}" and "[]".
 `)
 	fmt.Fprint(o, "Options:\n")
 	fmt.Fprint(o, "-f Comma-separated list of field names to flatten (default all).\n")
+	fmt.Fprint(o, "-j With -f, first JSON-decode named fields whose string values are JSON maps or\n")
+	fmt.Fprint(o, "   arrays, such as '{\"b\":1}' from CSV input, so those are flattened as well.\n")
 	fmt.Fprintf(o, "-s Separator, defaulting to %s --flatsep value.\n", "mlr")
 	fmt.Fprintf(o, "-h|--help Show this message.\n")
 }
@@ -50,6 +55,7 @@ func transformerFlattenParseCLI(
 
 	oFlatSep := "" // means take it from the record context
 	var fieldNames []string = nil
+	decodeJSONStrings := false
 
 	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
 		opt := args[argi]
@@ -71,12 +77,20 @@ func transformerFlattenParseCLI(
 		} else if opt == "-f" {
 			fieldNames = cli.VerbGetStringArrayArgOrDie(verb, opt, args, &argi, argc)
 
+		} else if opt == "-j" {
+			decodeJSONStrings = true
+
 		} else {
 			transformerFlattenUsage(os.Stderr)
 			os.Exit(1)
 		}
 	}
 
+	if decodeJSONStrings && fieldNames == nil {
+		transformerFlattenUsage(os.Stderr)
+		os.Exit(1)
+	}
+
 	*pargi = argi
 	if !doConstruct { // All transformers must do this for main command-line parsing
 		return nil
@@ -86,6 +100,7 @@ func transformerFlattenParseCLI(
 		oFlatSep,
 		options,
 		fieldNames,
+		decodeJSONStrings,
 	)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -101,6 +116,8 @@ type TransformerFlatten struct {
 	oFlatSep     string
 	options      *cli.TOptions
 	fieldNameSet map[string]bool
+	// JSON-decode string values of the -f fields before flattening
+	decodeJSONStrings bool
 
 	// state
 	recordTransformerFunc RecordTransformerFunc
@@ -110,6 +127,7 @@ func NewTransformerFlatten(
 	oFlatSep string,
 	options *cli.TOptions,
 	fieldNames []string,
+	decodeJSONStrings bool,
 ) (*TransformerFlatten, error) {
 	var fieldNameSet map[string]bool = nil
 	if fieldNames != nil {
@@ -120,6 +138,8 @@ func NewTransformerFlatten(
 		oFlatSep:     oFlatSep,
 		options:      options,
 		fieldNameSet: fieldNameSet,
+
+		decodeJSONStrings: decodeJSONStrings,
 	}
 
 	retval.recordTransformerFunc = retval.flattenAll
@@ -175,9 +195,31 @@ func (tr *TransformerFlatten) flattenSome(
 		if oFlatSep == "" {
 			oFlatSep = tr.options.WriterOptions.FLATSEP
 		}
+		if tr.decodeJSONStrings {
+			tr.decodeJSONFields(inrec)
+		}
 		inrec.FlattenFields(tr.fieldNameSet, oFlatSep)
 		outputRecordsAndContexts.PushBack(inrecAndContext)
 	} else {
 		outputRecordsAndContexts.PushBack(inrecAndContext) // end-of-stream marker
 	}
 }
+
+// decodeJSONFields replaces string values of the selected fields with their
+// JSON-decoded maps or arrays. Values which aren't JSON collections, or which
+// don't parse, are left as-is.
+func (tr *TransformerFlatten) decodeJSONFields(inrec *mlrval.Mlrmap) {
+	for pe := inrec.Head; pe != nil; pe = pe.Next {
+		if !tr.fieldNameSet[pe.Key] || !pe.Value.IsStringOrVoid() {
+			continue
+		}
+		input := strings.TrimSpace(pe.Value.String())
+		if !strings.HasPrefix(input, "{") && !strings.HasPrefix(input, "[") {
+			continue
+		}
+		decoded, err := mlrval.TryUnmarshalJSON([]byte(input))
+		if err == nil && decoded.IsArrayOrMap() {
+			pe.Value = decoded
+		}
+	}
+}
@@ -33,7 +33,7 @@ func transformerFormatValuesUsage(
 	fmt.Fprintf(o, "Applies format strings to all field values, depending on autodetected type.\n")
 	fmt.Fprintf(o, "* If a field value is detected to be integer, applies integer format.\n")
 	fmt.Fprintf(o, "* Else, if a field value is detected to be float, applies float format.\n")
-	fmt.Fprintf(o, "* Else, applies string format.\n")
+	fmt.Fprintf(o, "* Else, applies string format, if -s is given. Otherwise strings are left as-is.\n")
 	fmt.Fprintf(o, "\n")
 	fmt.Fprintf(o, "Note: this is a low-keystroke way to apply formatting to many fields. To get\n")
 	fmt.Fprintf(o, "finer control, please see the fmtnum function within the mlr put DSL.\n")
@@ -140,9 +140,14 @@ func NewTransformerFormatValues(
 	floatFormat string,
 	coerceIntToFloat bool,
 ) (*TransformerFormatValues, error) {
-	stringFormatter, err := mlrval.GetFormatter(stringFormat)
-	if err != nil {
-		return nil, err
+	// Strings are passed through unmodified unless a string format is requested
+	var stringFormatter mlrval.IFormatter = nil
+	if stringFormat != defaultFormatValuesStringFormat {
+		var err error
+		stringFormatter, err = mlrval.GetFormatter(stringFormat)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	intFormatter, err := mlrval.GetFormatter(intFormat)
@@ -183,7 +188,7 @@ func (tr *TransformerFormatValues) Transform(
 			_, isNumeric := pe.Value.GetNumericToFloatValue()
 			if isNumeric {
 				pe.Value = tr.floatFormatter.Format(pe.Value)
-			} else if pe.Value.IsStringOrVoid() {
+			} else if tr.stringFormatter != nil && pe.Value.IsStringOrVoid() {
 				pe.Value = tr.stringFormatter.Format(pe.Value)
 			} // else, don't rewrite booleans, arrays, maps, etc.
 		} else {
@@ -193,7 +198,7 @@ func (tr *TransformerFormatValues) Transform(
 				pe.Value = tr.intFormatter.Format(pe.Value)
 			} else if isFloat {
 				pe.Value = tr.floatFormatter.Format(pe.Value)
-			} else if pe.Value.IsStringOrVoid() {
+			} else if tr.stringFormatter != nil && pe.Value.IsStringOrVoid() {
 				pe.Value = tr.stringFormatter.Format(pe.Value)
 			} // else, don't rewrite booleans, arrays, maps, etc.
 		}
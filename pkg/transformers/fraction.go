@@ -39,6 +39,9 @@ func transformerFractionUsage(
 	fmt.Fprintf(o, "input records and accumulates sums; on the second pass it computes quotients\n")
 	fmt.Fprintf(o, "and emits output records. This means it produces no output until all input is read.\n")
 	fmt.Fprintf(o, "\n")
+	fmt.Fprintf(o, "Empty and non-numeric values are skipped: they don't count toward the sums, and\n")
+	fmt.Fprintf(o, "records having them are passed through without a fraction field.\n")
+	fmt.Fprintf(o, "\n")
 	fmt.Fprintf(o, "Options:\n")
 	fmt.Fprintf(o, "-f {a,b,c}    Field name(s) for fraction calculation\n")
 	fmt.Fprintf(o, "-g {d,e,f}    Optional group-by-field name(s) for fraction counts\n")
@@ -208,7 +211,7 @@ func (tr *TransformerFraction) Transform(
 
 		if hasAll {
 			sumsForGroup := tr.sums[groupingKey]
-			var cumusForGroup map[string]*mlrval.Mlrval = nil
+			cumusForGroup := tr.cumus[groupingKey]
 			if sumsForGroup == nil {
 				sumsForGroup = make(map[string]*mlrval.Mlrval)
 				tr.sums[groupingKey] = sumsForGroup
@@ -217,8 +220,8 @@ func (tr *TransformerFraction) Transform(
 			}
 			for _, fractionFieldName := range tr.fractionFieldNames {
 				value := inrec.Get(fractionFieldName)
-				if value != nil {
-					value.AssertNumeric() // may fatal the process
+				// Empty and non-numeric values don't count toward the denominator
+				if value != nil && value.IsNumeric() {
 					sum := sumsForGroup[fractionFieldName]
 					if sum == nil { // First value for group
 						sumsForGroup[fractionFieldName] = value.Copy()
@@ -251,9 +254,7 @@ func (tr *TransformerFraction) Transform(
 
 				for _, fractionFieldName := range tr.fractionFieldNames {
 					value := outrec.Get(fractionFieldName)
-					if value != nil {
-						value.AssertNumeric() // may fatal the process
-
+					if value != nil && value.IsNumeric() {
 						var numerator *mlrval.Mlrval = nil
 						var cumu *mlrval.Mlrval = nil
 						var outputValue *mlrval.Mlrval = nil
@@ -266,7 +267,7 @@ func (tr *TransformerFraction) Transform(
 						}
 
 						denominator := sumsForGroup[fractionFieldName]
-						if !mlrval.Equals(value, tr.zero) {
+						if !mlrval.Equals(denominator, tr.zero) {
 							outputValue = bifs.BIF_divide(numerator, denominator)
 							outputValue = bifs.BIF_times(outputValue, tr.multiplier)
 						} else {
@@ -27,11 +27,9 @@ func transformerGapUsage(
 	fmt.Fprintf(o, "Usage: %s %s [options]\n", "mlr", verbNameGap)
 	fmt.Fprint(o, "Emits an empty record every n records, or when certain values change.\n")
 	fmt.Fprintf(o, "Options:\n")
-
-	fmt.Fprintf(o, "Emits an empty record every n records, or when certain values change.\n")
 	fmt.Fprintf(o, "-g {a,b,c} Print a gap whenever values of these fields (e.g. a,b,c) changes.\n")
 	fmt.Fprintf(o, "-n {n} Print a gap every n records.\n")
-	fmt.Fprintf(o, "One of -f or -g is required.\n")
+	fmt.Fprintf(o, "One of -n or -g is required.\n")
 	fmt.Fprintf(o, "-n is ignored if -g is present.\n")
 	fmt.Fprintf(o, "-h|--help Show this message.\n")
 }
@@ -117,6 +115,10 @@ func NewTransformerGap(
 	groupByFieldNames []string,
 ) (*TransformerGap, error) {
 
+	if groupByFieldNames == nil && gapCount <= 0 {
+		return nil, fmt.Errorf("mlr %s: -n must be positive; got %d.", verbNameGap, gapCount)
+	}
+
 	tr := &TransformerGap{
 		gapCount:          gapCount,
 		groupByFieldNames: groupByFieldNames,
@@ -0,0 +1,187 @@
+package transformers
+
+import (
+	"container/list"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/johnkerl/miller/pkg/bifs"
+	"github.com/johnkerl/miller/pkg/cli"
+	"github.com/johnkerl/miller/pkg/lib"
+	"github.com/johnkerl/miller/pkg/mlrval"
+	"github.com/johnkerl/miller/pkg/types"
+)
+
+// ----------------------------------------------------------------
+const verbNameGMT2Sec = "gmt2sec"
+
+var GMT2SecSetup = TransformerSetup{
+	Verb:         verbNameGMT2Sec,
+	UsageFunc:    transformerGMT2SecUsage,
+	ParseCLIFunc: transformerGMT2SecParseCLI,
+	IgnoresInput: false,
+}
+
+func transformerGMT2SecUsage(
+	o *os.File,
+) {
+	fmt.Fprintf(o, "Usage: %s %s [options] {comma-separated list of field names}\n", "mlr", verbNameGMT2Sec)
+	fmt.Fprintf(o, "Replaces a field holding a GMT timestamp such as 2001-02-03T04:05:06Z with the\n")
+	fmt.Fprintf(o, "corresponding integer seconds since the epoch; this is the inverse of %s sec2gmt.\n", "mlr")
+	fmt.Fprintf(o, "Fractional seconds are rounded down (floor). Timestamps ending in Z are GMT;\n")
+	fmt.Fprintf(o, "timestamps with a numeric offset such as +01:00 are converted to GMT using\n")
+	fmt.Fprintf(o, "that offset; timestamps with no zone at all, such as 2001-02-03 04:05:06 or\n")
+	fmt.Fprintf(o, "2001-02-03, are taken to be GMT. Values which can't be parsed are left as-is.\n")
+	fmt.Fprintf(o, "Since fractional seconds are floored, 1969-12-31T23:59:59.5Z becomes -1, not 0.\n")
+	fmt.Fprintf(o, "Options:\n")
+	fmt.Fprintf(o, "--strict  Exit with an error on values which can't be parsed, rather than\n")
+	fmt.Fprintf(o, "          leaving them as-is. Empty values are always left as-is.\n")
+	fmt.Fprintf(o, "-h|--help Show this message.\n")
+	fmt.Fprintf(o, "Example:\n")
+	fmt.Fprintf(o, "  %s %s time1,time2\n", "mlr", verbNameGMT2Sec)
+}
+
+func transformerGMT2SecParseCLI(
+	pargi *int,
+	argc int,
+	args []string,
+	_ *cli.TOptions,
+	doConstruct bool, // false for first pass of CLI-parse, true for second pass
+) IRecordTransformer {
+
+	// Skip the verb name from the current spot in the mlr command line
+	argi := *pargi
+	argi++
+
+	strict := false
+
+	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
+		opt := args[argi]
+		if opt[0] != '-' {
+			break // No more flag options to process
+		}
+		if args[argi] == "--" {
+			break // All transformers must do this so main-flags can follow verb-flags
+		}
+		argi++
+
+		if opt == "-h" || opt == "--help" {
+			transformerGMT2SecUsage(os.Stdout)
+			os.Exit(0)
+
+		} else if opt == "--strict" {
+			strict = true
+
+		} else {
+			transformerGMT2SecUsage(os.Stderr)
+			os.Exit(1)
+		}
+	}
+
+	if argi >= argc {
+		transformerGMT2SecUsage(os.Stderr)
+		os.Exit(1)
+	}
+	fieldNames := args[argi]
+	argi++
+
+	*pargi = argi
+	if !doConstruct { // All transformers must do this for main command-line parsing
+		return nil
+	}
+
+	transformer, err := NewTransformerGMT2Sec(
+		fieldNames,
+		strict,
+	)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	return transformer
+}
+
+// ----------------------------------------------------------------
+type TransformerGMT2Sec struct {
+	fieldNameList []string
+	strict        bool
+}
+
+func NewTransformerGMT2Sec(
+	fieldNames string,
+	strict bool,
+) (*TransformerGMT2Sec, error) {
+	tr := &TransformerGMT2Sec{
+		fieldNameList: lib.SplitString(fieldNames, ","),
+		strict:        strict,
+	}
+	return tr, nil
+}
+
+// ----------------------------------------------------------------
+
+func (tr *TransformerGMT2Sec) Transform(
+	inrecAndContext *types.RecordAndContext,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+	inputDownstreamDoneChannel <-chan bool,
+	outputDownstreamDoneChannel chan<- bool,
+) {
+	HandleDefaultDownstreamDone(inputDownstreamDoneChannel, outputDownstreamDoneChannel)
+	if !inrecAndContext.EndOfStream {
+		inrec := inrecAndContext.Record
+		for _, fieldName := range tr.fieldNameList {
+			value := inrec.Get(fieldName)
+			if value == nil || value.IsVoid() {
+				continue
+			}
+			seconds, ok := gmt2secParse(value)
+			if ok {
+				inrec.PutReference(fieldName, mlrval.FromInt(seconds))
+			} else if tr.strict {
+				fmt.Fprintf(
+					os.Stderr,
+					"mlr %s: could not parse \"%s\" in field %s at %s record %d as a GMT timestamp.\n",
+					verbNameGMT2Sec, value.String(), fieldName,
+					inrecAndContext.Context.FILENAME, inrecAndContext.Context.FNR,
+				)
+				os.Exit(1)
+			}
+		}
+		outputRecordsAndContexts.PushBack(inrecAndContext)
+
+	} else { // End of record stream
+		outputRecordsAndContexts.PushBack(inrecAndContext) // end-of-stream marker
+	}
+}
+
+// gmt2secFallbackLayouts are tried, in order, for inputs which aren't in the
+// "%Y-%m-%dT%H:%M:%SZ" format accepted by the gmt2sec DSL function.  Layouts
+// without a zone are parsed by the time package as UTC.
+var gmt2secFallbackLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04Z",
+	"2006-01-02",
+}
+
+// gmt2secParse returns integer seconds since the epoch, rounding fractional
+// seconds toward negative infinity, along with false if the input can't be
+// parsed.
+func gmt2secParse(value *mlrval.Mlrval) (int64, bool) {
+	nanos := bifs.BIF_gmt2nsec(value)
+	if intValue, ok := nanos.GetIntValue(); ok {
+		return time.Unix(0, intValue).Unix(), true
+	}
+
+	input := value.String()
+	for _, layout := range gmt2secFallbackLayouts {
+		t, err := time.Parse(layout, input)
+		if err == nil {
+			return t.Unix(), true
+		}
+	}
+	return 0, false
+}
@@ -19,6 +19,8 @@ const (
 	havingFieldsAtLeast
 	havingFieldsWhichAre
 	havingFieldsAtMost
+	havingAllFieldsDefined
+	havingAnyFieldsDefined
 	havingAllFieldsMatching
 	havingAnyFieldsMatching
 	havingNoFieldsMatching
@@ -47,11 +49,16 @@ func transformerHavingFieldsUsage(
 	fmt.Fprintf(o, "  --at-least      {comma-separated names}\n")
 	fmt.Fprintf(o, "  --which-are     {comma-separated names}\n")
 	fmt.Fprintf(o, "  --at-most       {comma-separated names}\n")
+	fmt.Fprintf(o, "  --all-defined   {comma-separated names}\n")
+	fmt.Fprintf(o, "  --any-defined   {comma-separated names}\n")
 	fmt.Fprintf(o, "  --all-matching  {regular expression}\n")
 	fmt.Fprintf(o, "  --any-matching  {regular expression}\n")
 	fmt.Fprintf(o, "  --none-matching {regular expression}\n")
+	fmt.Fprintf(o, "The --all-defined and --any-defined options require the named fields to be\n")
+	fmt.Fprintf(o, "present with non-empty values; the others check only for presence.\n")
 	fmt.Fprintf(o, "Examples:\n")
 	fmt.Fprintf(o, "  %s %s --which-are amount,status,owner\n", exeName, verb)
+	fmt.Fprintf(o, "  %s %s --all-defined amount,status\n", exeName, verb)
 	fmt.Fprintf(o, "  %s %s --any-matching 'sda[0-9]'\n", exeName, verb)
 	fmt.Fprintf(o, "  %s %s --any-matching '\"sda[0-9]\"'\n", exeName, verb)
 	fmt.Fprintf(o, "  %s %s --any-matching '\"sda[0-9]\"i' (this is case-insensitive)\n", exeName, verb)
@@ -103,6 +110,16 @@ func transformerHavingFieldsParseCLI(
 			fieldNames = cli.VerbGetStringArrayArgOrDie(verb, opt, args, &argi, argc)
 			regexString = ""
 
+		} else if opt == "--all-defined" {
+			havingFieldsCriterion = havingAllFieldsDefined
+			fieldNames = cli.VerbGetStringArrayArgOrDie(verb, opt, args, &argi, argc)
+			regexString = ""
+
+		} else if opt == "--any-defined" {
+			havingFieldsCriterion = havingAnyFieldsDefined
+			fieldNames = cli.VerbGetStringArrayArgOrDie(verb, opt, args, &argi, argc)
+			regexString = ""
+
 		} else if opt == "--all-matching" {
 			havingFieldsCriterion = havingAllFieldsMatching
 			regexString = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
@@ -182,6 +199,10 @@ func NewTransformerHavingFields(
 			tr.recordTransformerFunc = tr.transformHavingFieldsWhichAre
 		} else if havingFieldsCriterion == havingFieldsAtMost {
 			tr.recordTransformerFunc = tr.transformHavingFieldsAtMost
+		} else if havingFieldsCriterion == havingAllFieldsDefined {
+			tr.recordTransformerFunc = tr.transformHavingAllFieldsDefined
+		} else if havingFieldsCriterion == havingAnyFieldsDefined {
+			tr.recordTransformerFunc = tr.transformHavingAnyFieldsDefined
 		} else {
 			lib.InternalCodingErrorIf(true)
 		}
@@ -295,6 +316,56 @@ func (tr *TransformerHavingFields) transformHavingFieldsAtMost(
 	}
 }
 
+// ----------------------------------------------------------------
+func (tr *TransformerHavingFields) transformHavingAllFieldsDefined(
+	inrecAndContext *types.RecordAndContext,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+	inputDownstreamDoneChannel <-chan bool,
+	outputDownstreamDoneChannel chan<- bool,
+) {
+	if !inrecAndContext.EndOfStream {
+		inrec := inrecAndContext.Record
+		numFound := int64(0)
+		for pe := inrec.Head; pe != nil; pe = pe.Next {
+			if tr.fieldNameSet[pe.Key] {
+				if pe.Value.IsVoid() {
+					return
+				}
+				numFound++
+				if numFound == tr.numFieldNames {
+					outputRecordsAndContexts.PushBack(inrecAndContext)
+					return
+				}
+			}
+		}
+
+	} else {
+		outputRecordsAndContexts.PushBack(inrecAndContext)
+	}
+}
+
+func (tr *TransformerHavingFields) transformHavingAnyFieldsDefined(
+	inrecAndContext *types.RecordAndContext,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+	inputDownstreamDoneChannel <-chan bool,
+	outputDownstreamDoneChannel chan<- bool,
+) {
+	if !inrecAndContext.EndOfStream {
+		inrec := inrecAndContext.Record
+		for pe := inrec.Head; pe != nil; pe = pe.Next {
+			if tr.fieldNameSet[pe.Key] {
+				if !pe.Value.IsVoid() {
+					outputRecordsAndContexts.PushBack(inrecAndContext)
+					return
+				}
+			}
+		}
+
+	} else {
+		outputRecordsAndContexts.PushBack(inrecAndContext)
+	}
+}
+
 // ----------------------------------------------------------------
 func (tr *TransformerHavingFields) transformHavingAllFieldsMatching(
 	inrecAndContext *types.RecordAndContext,
@@ -3,6 +3,7 @@ package transformers
 import (
 	"container/list"
 	"fmt"
+	"math"
 	"os"
 	"strings"
 
@@ -28,7 +29,9 @@ func transformerHistogramUsage(
 ) {
 	argv0 := "mlr"
 	verb := verbNameHistogram
-	fmt.Fprintf(o, "Just a histogram. Input values < lo or > hi are not counted.\n")
+	fmt.Fprintf(o, "Just a histogram. Input values < lo or > hi are not counted, unless --overflow\n")
+	fmt.Fprintf(o, "is given. Bins include their low edge and exclude their high edge, except that\n")
+	fmt.Fprintf(o, "the last bin also includes hi.\n")
 	fmt.Fprintf(o, "Usage: %s %s [options]\n", argv0, verb)
 	fmt.Fprintf(o, "-f {a,b,c}    Value-field names for histogram counts\n")
 	fmt.Fprintf(o, "--lo {lo}     Histogram low value\n")
@@ -36,6 +39,9 @@ func transformerHistogramUsage(
 	fmt.Fprintf(o, "--nbins {n}   Number of histogram bins. Defaults to %d.\n", histogramDefaultBinCount)
 	fmt.Fprintf(o, "--auto        Automatically computes limits, ignoring --lo and --hi.\n")
 	fmt.Fprintf(o, "              Holds all values in memory before producing any output.\n")
+	fmt.Fprintf(o, "--overflow    Also emit an underflow bin, from -Inf to lo, and an overflow bin,\n")
+	fmt.Fprintf(o, "              from hi to +Inf, counting values outside the histogram limits.\n")
+	fmt.Fprintf(o, "              Ignored with --auto, since then there are no such values.\n")
 	fmt.Fprintf(o, "-o {prefix}   Prefix for output field name. Default: no prefix.\n")
 	fmt.Fprintf(o, "-h|--help Show this message.\n")
 }
@@ -59,6 +65,7 @@ func transformerHistogramParseCLI(
 	nbins := histogramDefaultBinCount
 	hi := 0.0
 	doAuto := false
+	doOverflow := false
 	outputPrefix := ""
 
 	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
@@ -90,6 +97,9 @@ func transformerHistogramParseCLI(
 		} else if opt == "--auto" {
 			doAuto = true
 
+		} else if opt == "--overflow" {
+			doOverflow = true
+
 		} else if opt == "-o" {
 			outputPrefix = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
 
@@ -125,6 +135,7 @@ func transformerHistogramParseCLI(
 		nbins,
 		hi,
 		doAuto,
+		doOverflow,
 		outputPrefix,
 	)
 	if err != nil {
@@ -149,6 +160,11 @@ type TransformerHistogram struct {
 	vectorsByFieldName map[string][]float64 // For auto-mode
 	outputPrefix       string
 
+	// For --overflow: counts of values below lo, and above hi.
+	doOverflow             bool
+	underflowCountsByField map[string]int64
+	overflowCountsByField  map[string]int64
+
 	recordTransformerFunc RecordTransformerFunc
 }
 
@@ -159,6 +175,7 @@ func NewTransformerHistogram(
 	nbins int64,
 	hi float64,
 	doAuto bool,
+	doOverflow bool,
 	outputPrefix string,
 ) (*TransformerHistogram, error) {
 
@@ -175,6 +192,10 @@ func NewTransformerHistogram(
 		countsByField:   countsByField,
 		outputPrefix:    outputPrefix,
 		nbins:           nbins,
+
+		doOverflow:             doOverflow && !doAuto,
+		underflowCountsByField: make(map[string]int64),
+		overflowCountsByField:  make(map[string]int64),
 	}
 
 	if !doAuto {
@@ -243,6 +264,10 @@ func (tr *TransformerHistogram) ingestNonAuto(
 			} else if floatValue == tr.hi {
 				idx := tr.nbins - 1
 				tr.countsByField[valueFieldName][idx]++
+			} else if floatValue < tr.lo {
+				tr.underflowCountsByField[valueFieldName]++
+			} else {
+				tr.overflowCountsByField[valueFieldName]++
 			}
 		}
 	}
@@ -256,6 +281,14 @@ func (tr *TransformerHistogram) emitNonAuto(
 	for _, valueFieldName := range tr.valueFieldNames {
 		countFieldNames[valueFieldName] = tr.outputPrefix + valueFieldName + "_count"
 	}
+
+	if tr.doOverflow {
+		tr.emitOverflowBin(
+			math.Inf(-1), tr.lo, tr.underflowCountsByField, countFieldNames,
+			endOfStreamContext, outputRecordsAndContexts,
+		)
+	}
+
 	for i := int64(0); i < tr.nbins; i++ {
 		outrec := mlrval.NewMlrmapAsRecord()
 
@@ -277,6 +310,33 @@ func (tr *TransformerHistogram) emitNonAuto(
 
 		outputRecordsAndContexts.PushBack(types.NewRecordAndContext(outrec, endOfStreamContext))
 	}
+
+	if tr.doOverflow {
+		tr.emitOverflowBin(
+			tr.hi, math.Inf(1), tr.overflowCountsByField, countFieldNames,
+			endOfStreamContext, outputRecordsAndContexts,
+		)
+	}
+}
+
+func (tr *TransformerHistogram) emitOverflowBin(
+	binLo float64,
+	binHi float64,
+	countsByField map[string]int64,
+	countFieldNames map[string]string,
+	endOfStreamContext *types.Context,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+) {
+	outrec := mlrval.NewMlrmapAsRecord()
+	outrec.PutReference(tr.outputPrefix+"bin_lo", mlrval.FromFloat(binLo))
+	outrec.PutReference(tr.outputPrefix+"bin_hi", mlrval.FromFloat(binHi))
+	for _, valueFieldName := range tr.valueFieldNames {
+		outrec.PutReference(
+			countFieldNames[valueFieldName],
+			mlrval.FromInt(countsByField[valueFieldName]),
+		)
+	}
+	outputRecordsAndContexts.PushBack(types.NewRecordAndContext(outrec, endOfStreamContext))
 }
 
 // ----------------------------------------------------------------
@@ -514,7 +514,7 @@ func (tr *TransformerJoin) ingestLeftFile() {
 		select {
 
 		case err := <-errorChannel:
-			fmt.Fprintln(os.Stderr, "mlr", ": ", err)
+			fmt.Fprintf(os.Stderr, "mlr join: %v\n", err)
 			os.Exit(1)
 
 		case leftrecsAndContexts := <-readerChannel:
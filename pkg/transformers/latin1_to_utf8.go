@@ -6,9 +6,8 @@ import (
 	"os"
 	"strings"
 
+	"github.com/johnkerl/miller/pkg/bifs"
 	"github.com/johnkerl/miller/pkg/cli"
-	"github.com/johnkerl/miller/pkg/lib"
-	"github.com/johnkerl/miller/pkg/mlrval"
 	"github.com/johnkerl/miller/pkg/types"
 )
 
@@ -100,15 +99,7 @@ func (tr *TransformerLatin1ToUTF8) Transform(
 		inrec := inrecAndContext.Record
 
 		for pe := inrec.Head; pe != nil; pe = pe.Next {
-			inval := pe.Value
-			if inval.IsString() {
-				output, err := lib.TryLatin1ToUTF8(pe.Value.String())
-				if err == nil {
-					pe.Value = mlrval.FromString(output)
-				} else {
-					pe.Value = mlrval.FromError(err)
-				}
-			}
+			pe.Value = bifs.BIF_latin1_to_utf8(pe.Value)
 		}
 
 		outputRecordsAndContexts.PushBack(types.NewRecordAndContext(inrec, &inrecAndContext.Context))
@@ -43,7 +43,8 @@ func transformerMergeFieldsUsage(
 	fmt.Fprintf(o, "Options:\n")
 	fmt.Fprintf(o, "-a {sum,count,...}  Names of accumulators. One or more of:\n")
 	utils.ListStats1Accumulators(o)
-	fmt.Fprintf(o, "-f {a,b,c}  Value-field names on which to compute statistics. Requires -o.\n")
+	fmt.Fprintf(o, "-f {a,b,c}  Value-field names on which to compute statistics. Output field\n")
+	fmt.Fprintf(o, "            basename defaults to the field names joined with \"_\", e.g. a_b_c.\n")
 	fmt.Fprintf(o, "-r {a,b,c}  Regular expressions for value-field names on which to compute\n")
 	fmt.Fprintf(o, "            statistics. Requires -o.\n")
 	fmt.Fprintf(o, "-c {a,b,c}  Substrings for collapse mode. All fields which have the same names\n")
@@ -51,7 +52,7 @@ func transformerMergeFieldsUsage(
 	fmt.Fprintf(o, "            examples below.\n")
 	fmt.Fprintf(o, "-i          Use interpolated percentiles, like R's type=7; default like type=1.\n")
 	fmt.Fprintf(o, "            Not sensical for string-valued fields.\n")
-	fmt.Fprintf(o, "-o {name}   Output field basename for -f/-r.\n")
+	fmt.Fprintf(o, "-o {name}   Output field basename for -f/-r. Optional for -f.\n")
 	fmt.Fprintf(o, "-k          Keep the input fields which contributed to the output statistics;\n")
 	fmt.Fprintf(o, "            the default is to omit them.\n")
 	fmt.Fprintf(o, "\n")
@@ -153,7 +154,9 @@ func transformerMergeFieldsParseCLI(
 		os.Exit(1)
 	}
 	if outputFieldBasename == "" {
-		if doWhich == e_MERGE_BY_NAME_LIST || doWhich == e_MERGE_BY_NAME_REGEX {
+		if doWhich == e_MERGE_BY_NAME_LIST {
+			outputFieldBasename = strings.Join(valueFieldNameList, "_")
+		} else if doWhich == e_MERGE_BY_NAME_REGEX {
 			transformerMergeFieldsUsage(os.Stderr)
 			os.Exit(1)
 		}
@@ -345,13 +348,6 @@ func (tr *TransformerMergeFields) transformByNameList(
 			continue
 		}
 
-		if mvalue.IsVoid() { // key present with empty value
-			if !tr.keepInputFields {
-				inrec.Remove(valueFieldName)
-			}
-			continue
-		}
-
 		for pa := tr.namedAccumulators.Head; pa != nil; pa = pa.Next {
 			accumulator := pa.Value.(*utils.Stats1NamedAccumulator)
 			accumulator.Ingest(mvalue)
@@ -412,17 +408,6 @@ func (tr *TransformerMergeFields) transformByNameRegex(
 			continue
 		}
 
-		if mvalue.IsVoid() { // Key present with empty value
-			if !tr.keepInputFields { // We are modifying the record while iterating over it.
-				next := pe.Next
-				inrec.Unlink(pe)
-				pe = next
-			} else {
-				pe = pe.Next
-			}
-			continue
-		}
-
 		for pa := tr.namedAccumulators.Head; pa != nil; pa = pa.Next {
 			accumulator := pa.Value.(*utils.Stats1NamedAccumulator)
 			accumulator.Ingest(mvalue)
@@ -512,20 +497,6 @@ func (tr *TransformerMergeFields) transformByCollapsing(
 			namedAccumulators = iNamedAccumulators.(*lib.OrderedMap)
 		}
 
-		// The accumulator has been initialized with default values; continue
-		// here. (If we were to continue before namedAccumulators.Put(...) we
-		// would be failing to construct the accumulator.)
-		if mvalue.IsVoid() { // key present with empty value
-			if !tr.keepInputFields { // We are modifying the record while iterating over it.
-				next := pe.Next
-				inrec.Unlink(pe)
-				pe = next
-			} else {
-				pe = pe.Next
-			}
-			continue
-		}
-
 		for pa := namedAccumulators.Head; pa != nil; pa = pa.Next {
 			accumulator := pa.Value.(*utils.Stats1NamedAccumulator)
 			accumulator.Ingest(mvalue)
@@ -55,6 +55,7 @@ func transformerNestUsage(
 	fmt.Fprintf(o, "    \"x=b,y=d\"\n")
 	fmt.Fprintf(o, "    \"x=c,y=d\"\n")
 	fmt.Fprintf(o, "  Use --implode to do the reverse.\n")
+	fmt.Fprintf(o, "  The shorthand for this is \"%s %s --evar ';' -f x\".\n", argv0, verb)
 
 	fmt.Fprintf(o, "\n")
 	fmt.Fprintf(o, "  %s %s --explode --values --across-fields -f x\n", argv0, verb)
@@ -78,6 +79,9 @@ func transformerNestUsage(
 	fmt.Fprintf(o, "Notes:\n")
 	fmt.Fprintf(o, "* With --pairs, --implode doesn't make sense since the original field name has\n")
 	fmt.Fprintf(o, "  been lost.\n")
+	fmt.Fprintf(o, "* With --explode --pairs, a piece without the nested-ps keeps the original\n")
+	fmt.Fprintf(o, "  field name, and a pair whose name is already in the record replaces that\n")
+	fmt.Fprintf(o, "  field's value.\n")
 	fmt.Fprintf(o, "* The combination \"--implode --values --across-records\" is non-streaming:\n")
 	fmt.Fprintf(o, "  no output records are produced until all input records have been read. In\n")
 	fmt.Fprintf(o, "  particular, this means it won't work in `tail -f` contexts. But all other flag\n")
@@ -111,8 +115,6 @@ func transformerNestParseCLI(
 	doExplodeSpecified := false
 	doPairsSpecified := false
 	doAcrossFieldsSpecified := false
-	evfs := ""
-	ivfs := ""
 
 	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
 		opt := args[argi]
@@ -158,7 +160,9 @@ func transformerNestParseCLI(
 			nestedPS = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
 
 		} else if opt == "--evar" {
-			evfs = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
+			// Expands to the long form in place, so later flags such as
+			// --nested-fs take precedence as they would there.
+			nestedFS = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
 			doExplode = true
 			doExplodeSpecified = true
 			doPairs = false
@@ -167,7 +171,7 @@ func transformerNestParseCLI(
 			doAcrossFieldsSpecified = true
 
 		} else if opt == "--ivar" {
-			ivfs = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
+			nestedFS = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
 			doExplode = false
 			doExplodeSpecified = true
 			doPairs = false
@@ -181,19 +185,6 @@ func transformerNestParseCLI(
 		}
 	}
 
-	if evfs != "" {
-		doExplode = true
-		doPairs = false
-		doAcrossFields = false
-		nestedFS = evfs
-	}
-	if ivfs != "" {
-		doExplode = false
-		doPairs = false
-		doAcrossFields = false
-		nestedFS = ivfs
-	}
-
 	if fieldName == "" {
 		transformerNestUsage(os.Stderr)
 		os.Exit(1)
@@ -411,26 +402,7 @@ func (tr *TransformerNest) explodePairsAcrossFields(
 		mvalue := originalEntry.Value
 		svalue := mvalue.String()
 
-		recordEntry := originalEntry
-		pieces := lib.SplitString(svalue, tr.nestedFS)
-		for _, piece := range pieces {
-			pair := strings.SplitN(piece, tr.nestedPS, 2)
-			if len(pair) == 2 { // there is a pair
-				recordEntry = inrec.PutReferenceAfter(
-					recordEntry,
-					pair[0],
-					mlrval.FromString(pair[1]),
-				)
-			} else { // there is not a pair
-				recordEntry = inrec.PutReferenceAfter(
-					recordEntry,
-					tr.fieldName,
-					mlrval.FromString(piece),
-				)
-			}
-		}
-
-		inrec.Unlink(originalEntry)
+		tr.explodePairsInPlace(inrec, originalEntry, svalue)
 		outputRecordsAndContexts.PushBack(inrecAndContext)
 
 	} else {
@@ -438,6 +410,46 @@ func (tr *TransformerNest) explodePairsAcrossFields(
 	}
 }
 
+// explodePairsInPlace replaces the original entry with the pairs in svalue, e.g. "a:1;b:2". The
+// new fields go where the old one was -- unless there's already a field with the new name, in which
+// case its value is replaced. A piece without a pair separator keeps the original field name.
+func (tr *TransformerNest) explodePairsInPlace(
+	rec *mlrval.Mlrmap,
+	originalEntry *mlrval.MlrmapEntry,
+	svalue string,
+) {
+	recordEntry := originalEntry
+	keepOriginal := false
+
+	// Not lib.SplitString so 'x=' will map to 'x=', rather than no field at all
+	pieces := strings.Split(svalue, tr.nestedFS)
+	for _, piece := range pieces {
+		var key string
+		var value *mlrval.Mlrval
+		pair := strings.SplitN(piece, tr.nestedPS, 2)
+		if len(pair) == 2 { // there is a pair
+			key = pair[0]
+			value = mlrval.FromString(pair[1])
+		} else { // there is not a pair
+			key = tr.fieldName
+			value = mlrval.FromString(piece)
+		}
+
+		if key == tr.fieldName {
+			originalEntry.Value = value
+			keepOriginal = true
+		} else if existingEntry := rec.GetEntry(key); existingEntry != nil {
+			existingEntry.Value = value
+		} else {
+			recordEntry = rec.PutReferenceAfter(recordEntry, key, value)
+		}
+	}
+
+	if !keepOriginal {
+		rec.Unlink(originalEntry)
+	}
+}
+
 // ----------------------------------------------------------------
 func (tr *TransformerNest) explodePairsAcrossRecords(
 	inrecAndContext *types.RecordAndContext,
@@ -454,22 +466,13 @@ func (tr *TransformerNest) explodePairsAcrossRecords(
 		}
 
 		svalue := mvalue.String()
-		pieces := lib.SplitString(svalue, tr.nestedFS)
+
+		// Not lib.SplitString so 'x=' will map to 'x=', rather than no record at all
+		pieces := strings.Split(svalue, tr.nestedFS)
 		for _, piece := range pieces {
 			outrec := inrec.Copy()
-
 			originalEntry := outrec.GetEntry(tr.fieldName)
-
-			// Put the new field where the old one was -- unless there's already a field with the new
-			// name, in which case replace its value.
-			pair := strings.SplitN(piece, tr.nestedPS, 2)
-			if len(pair) == 2 { // there is a pair
-				outrec.PutReferenceAfter(originalEntry, pair[0], mlrval.FromString(pair[1]))
-			} else { // there is not a pair
-				outrec.PutReferenceAfter(originalEntry, tr.fieldName, mlrval.FromString(piece))
-			}
-
-			outrec.Unlink(originalEntry)
+			tr.explodePairsInPlace(outrec, originalEntry, piece)
 			outputRecordsAndContexts.PushBack(types.NewRecordAndContext(outrec, &inrecAndContext.Context))
 		}
 
@@ -81,14 +81,20 @@ semicolons to separate expressions.)
     Note: the value may be an environment variable, e.g. -s sequence=$SEQUENCE
 
 -x (default false) Prints records for which {expression} evaluates to false, not true,
-   i.e. invert the sense of the filter expression.
+   i.e. invert the sense of the filter expression. Records for which {expression}
+   is absent, an error, or otherwise not boolean are not printed, with or without -x.
 
 -q Does not include the modified record in the output stream.
    Useful for when all desired output is in begin and/or end blocks.
 
--S and -F: There are no-ops in Miller 6 and above, since now type-inferencing is done
-   by the record-readers before filter/put is executed. Supported as no-op pass-through
-   flags for backward compatibility.
+-S Treats all field values as strings within the expression: no type inference is
+   done on them, so e.g. $x . $y concatenates, $x == "0" compares literally, and
+   $x + $y is an error. Values assigned to fields keep the types they are assigned
+   with, and fields not assigned to are output unchanged.
+
+-F A no-op in Miller 6 and above, since now type-inferencing is done by the
+   record-readers before filter/put is executed. Supported as a no-op pass-through
+   flag for backward compatibility.
 
 -h|--help Show this message.
 
@@ -146,7 +152,9 @@ More example put expressions:
 		fmt.Fprintln(o)
 		fmt.Fprintf(o, `Records will pass the filter depending on the last bare-boolean statement in
 the DSL expression. That can be the result of <, ==, >, etc., the return value of a function call
-which returns boolean, etc.
+which returns boolean, etc. If no bare-boolean statement is executed for a record, the record
+passes. If the last one evaluates to absent (e.g. '$nosuch > 3'), an error, or any other
+non-boolean value, the record is dropped.
 `)
 		fmt.Fprintln(o)
 		fmt.Fprint(o, `Examples:
@@ -205,6 +213,7 @@ func transformerPutOrFilterParseCLI(
 	doWarnings := false
 	warningsAreFatal := false
 	strictMode := false
+	stringFieldValues := false
 	invertFilter := false
 	suppressOutputRecord := false
 	presets := make([]string, 0)
@@ -301,12 +310,11 @@ func transformerPutOrFilterParseCLI(
 			warningsAreFatal = true
 
 		} else if opt == "-S" {
-			// TODO: this is a no-op in Miller 6 and above.
-			// Comment this in more detail.
+			stringFieldValues = true
 
 		} else if opt == "-F" {
-			// TODO: this is a no-op in Miller 6 and above.
-			// Comment this in more detail.
+			// This was for float-only arithmetic in Miller 5. It's a no-op
+			// in Miller 6 and above, where the record-readers infer types.
 
 		} else {
 			// This is inelegant. For error-proofing we advance argi already in our
@@ -362,6 +370,7 @@ func transformerPutOrFilterParseCLI(
 		doWarnings,
 		warningsAreFatal,
 		strictMode,
+		stringFieldValues,
 		invertFilter,
 		suppressOutputRecord,
 		options,
@@ -396,6 +405,7 @@ func NewTransformerPut(
 	doWarnings bool,
 	warningsAreFatal bool,
 	strictMode bool,
+	stringFieldValues bool,
 	invertFilter bool,
 	suppressOutputRecord bool,
 	options *cli.TOptions,
@@ -450,6 +460,7 @@ func NewTransformerPut(
 	}
 
 	runtimeState := runtime.NewEmptyState(options, strictMode)
+	runtimeState.StringFieldValues = stringFieldValues
 
 	// E.g.
 	//   mlr put -s sum=0
@@ -459,7 +470,10 @@ func NewTransformerPut(
 		for _, preset := range presets {
 			pair := strings.SplitN(preset, "=", 2)
 			if len(pair) != 2 {
-				return nil, fmt.Errorf("missing \"=\" in preset expression \"%s\".", preset)
+				return nil, fmt.Errorf("mlr: missing \"=\" in preset expression \"%s\".", preset)
+			}
+			if pair[0] == "" {
+				return nil, fmt.Errorf("mlr: empty name in preset expression \"%s\".", preset)
 			}
 			key := pair[0]
 			svalue := pair[1]
@@ -504,6 +518,8 @@ func (tr *TransformerPut) Transform(
 		}
 
 		tr.runtimeState.Update(inrec, &context)
+		// The filter condition from the previous record, if any, doesn't carry over
+		tr.runtimeState.FilterExpression = mlrval.TRUE
 
 		// Execute the main block on the current input record
 		outrec, err := tr.cstRootNode.ExecuteMainBlock(tr.runtimeState)
@@ -513,11 +529,10 @@ func (tr *TransformerPut) Transform(
 		}
 
 		if !tr.suppressOutputRecord {
+			// Non-boolean filter conditions, including absent and error,
+			// drop the record whether or not -x was given.
 			filterBool, isBool := tr.runtimeState.FilterExpression.GetBoolValue()
-			if !isBool {
-				filterBool = false
-			}
-			wantToEmit := lib.BooleanXOR(filterBool, tr.invertFilter)
+			wantToEmit := isBool && lib.BooleanXOR(filterBool, tr.invertFilter)
 			if wantToEmit {
 				outputRecordsAndContexts.PushBack(types.NewRecordAndContext(outrec, &context))
 			}
@@ -37,7 +37,10 @@ func transformerRepeatUsage(
 	fmt.Fprintf(o, "Options must be exactly one of the following:\n")
 	fmt.Fprintf(o, "-n {repeat count}  Repeat each input record this many times.\n")
 	fmt.Fprintf(o, "-f {field name}    Same, but take the repeat count from the specified\n")
-	fmt.Fprintf(o, "                   field name of each input record.\n")
+	fmt.Fprintf(o, "                   field name of each input record. Records where that\n")
+	fmt.Fprintf(o, "                   field is absent or non-numeric are emitted once.\n")
+	fmt.Fprintf(o, "--strict           With -f, emit records where the repeat-count field is\n")
+	fmt.Fprintf(o, "                   absent or non-numeric zero times, rather than once.\n")
 	fmt.Fprintf(o, "-h|--help Show this message.\n")
 	fmt.Fprintf(o, "Example:\n")
 	fmt.Fprintf(o, "  echo x=0 | %s %s -n 4 then put '$x=urand()'\n", "mlr", verbNameRepeat)
@@ -70,6 +73,7 @@ func transformerRepeatParseCLI(
 	repeatCountSource := repeatCountSourceUnspecified
 	repeatCount := int64(0)
 	repeatCountFieldName := ""
+	strict := false
 
 	// Skip the verb name from the current spot in the mlr command line
 	argi := *pargi
@@ -98,6 +102,9 @@ func transformerRepeatParseCLI(
 			repeatCountFieldName = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
 			repeatCountSource = repeatCountFromFieldName
 
+		} else if opt == "--strict" {
+			strict = true
+
 		} else {
 			transformerRepeatUsage(os.Stderr)
 			os.Exit(1)
@@ -118,6 +125,7 @@ func transformerRepeatParseCLI(
 		repeatCountSource,
 		repeatCount,
 		repeatCountFieldName,
+		strict,
 	)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -131,6 +139,7 @@ func transformerRepeatParseCLI(
 type TransformerRepeat struct {
 	repeatCount           int64
 	repeatCountFieldName  string
+	strict                bool
 	recordTransformerFunc RecordTransformerFunc
 }
 
@@ -139,11 +148,13 @@ func NewTransformerRepeat(
 	repeatCountSource tRepeatCountSource,
 	repeatCount int64,
 	repeatCountFieldName string,
+	strict bool,
 ) (*TransformerRepeat, error) {
 
 	tr := &TransformerRepeat{
 		repeatCount:          repeatCount,
 		repeatCountFieldName: repeatCountFieldName,
+		strict:               strict,
 	}
 
 	if repeatCountSource == repeatCountFromInt {
@@ -194,15 +205,21 @@ func (tr *TransformerRepeat) repeatByFieldName(
 	outputDownstreamDoneChannel chan<- bool,
 ) {
 	if !inrecAndContext.EndOfStream {
-		fieldValue := inrecAndContext.Record.Get(tr.repeatCountFieldName)
-		if fieldValue == nil {
-			return
+		// Absent or non-numeric counts mean once, or zero times if strict
+		repeatCount := int64(1)
+		if tr.strict {
+			repeatCount = 0
 		}
-		repeatCount, ok := fieldValue.GetIntValue()
-		if !ok {
-			return
+		fieldValue := inrecAndContext.Record.Get(tr.repeatCountFieldName)
+		if fieldValue != nil {
+			if fieldValue.IsInt() {
+				repeatCount, _ = fieldValue.GetIntValue()
+			} else if fieldValue.IsFloat() {
+				floatValue, _ := fieldValue.GetFloatValue()
+				repeatCount = int64(floatValue)
+			}
 		}
-		for i := 0; i < int(repeatCount); i++ {
+		for i := int64(0); i < repeatCount; i++ {
 			outputRecordsAndContexts.PushBack(types.NewRecordAndContext(
 				inrecAndContext.Record.Copy(),
 				&inrecAndContext.Context,
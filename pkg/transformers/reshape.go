@@ -71,6 +71,7 @@ func transformerReshapeUsage(
 	fmt.Fprintf(o, "Long-to-wide options:\n")
 	fmt.Fprintf(o, "  -s {key-field name,value-field name}\n")
 	fmt.Fprintf(o, "  These pivot/reshape the input data to undo the wide-to-long operation.\n")
+	fmt.Fprintf(o, "  Keys missing for some output records are filled in with empty values.\n")
 	fmt.Fprintf(o, "  Note: this does not work with tail -f; it produces output records only after\n")
 	fmt.Fprintf(o, "  all input records have been read.\n")
 	fmt.Fprintf(o, "\n")
@@ -427,6 +428,19 @@ func (tr *TransformerReshape) longToWide(
 
 	} else {
 
+		// Not all buckets need have all the same split-out keys. Fill in the missing ones with
+		// empty values so the output is rectangular.
+		unionedKeys := lib.NewOrderedMap()
+		for pe := tr.otherKeysToOtherValuesToBuckets.Head; pe != nil; pe = pe.Next {
+			otherValuesToBuckets := pe.Value.(*lib.OrderedMap)
+			for pf := otherValuesToBuckets.Head; pf != nil; pf = pf.Next {
+				bucket := pf.Value.(*tReshapeBucket)
+				for pg := bucket.pairs.Head; pg != nil; pg = pg.Next {
+					unionedKeys.Put(pg.Key, true)
+				}
+			}
+		}
+
 		for pe := tr.otherKeysToOtherValuesToBuckets.Head; pe != nil; pe = pe.Next {
 			otherValuesToBuckets := pe.Value.(*lib.OrderedMap)
 			for pf := otherValuesToBuckets.Head; pf != nil; pf = pf.Next {
@@ -434,8 +448,13 @@ func (tr *TransformerReshape) longToWide(
 				outrec := bucket.representative
 				bucket.representative = nil // ownership transfer
 
-				for pg := bucket.pairs.Head; pg != nil; pg = pg.Next {
-					outrec.PutReference(pg.Key, pg.Value)
+				for pu := unionedKeys.Head; pu != nil; pu = pu.Next {
+					value := bucket.pairs.Get(pu.Key)
+					if value == nil {
+						outrec.PutCopy(pu.Key, mlrval.VOID)
+					} else {
+						outrec.PutReference(pu.Key, value)
+					}
 				}
 
 				outputRecordsAndContexts.PushBack(types.NewRecordAndContext(outrec, &inrecAndContext.Context))
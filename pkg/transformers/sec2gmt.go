@@ -3,6 +3,7 @@ package transformers
 import (
 	"container/list"
 	"fmt"
+	"math"
 	"os"
 
 	"github.com/johnkerl/miller/pkg/cli"
@@ -33,9 +34,11 @@ func transformerSec2GMTUsage(
 	fmt.Fprintf(o, "  %s put '$time1 = sec2gmt($time1); $time2 = sec2gmt($time2)'\n", "mlr")
 	fmt.Fprintf(o, "Options:\n")
 	fmt.Fprintf(o, "-1 through -9: format the seconds using 1..9 decimal places, respectively.\n")
-	fmt.Fprintf(o, "--millis Input numbers are treated as milliseconds since the epoch.\n")
-	fmt.Fprintf(o, "--micros Input numbers are treated as microseconds since the epoch.\n")
-	fmt.Fprintf(o, "--nanos  Input numbers are treated as nanoseconds since the epoch.\n")
+	fmt.Fprintf(o, "--millis|--millis2gmt Input numbers are treated as milliseconds since the epoch.\n")
+	fmt.Fprintf(o, "--micros|--micros2gmt Input numbers are treated as microseconds since the epoch.\n")
+	fmt.Fprintf(o, "--nanos|--nanos2gmt   Input numbers are treated as nanoseconds since the epoch.\n")
+	fmt.Fprintf(o, "Integer inputs with these options are converted exactly, without loss of\n")
+	fmt.Fprintf(o, "sub-second precision, for times between the years 1678 and 2262.\n")
 	fmt.Fprintf(o, "-h|--help Show this message.\n")
 }
 
@@ -87,11 +90,11 @@ func transformerSec2GMTParseCLI(
 		} else if opt == "-9" {
 			numDecimalPlaces = 9
 
-		} else if opt == "--millis" {
+		} else if opt == "--millis" || opt == "--millis2gmt" {
 			preDivide = 1.0e3
-		} else if opt == "--micros" {
+		} else if opt == "--micros" || opt == "--micros2gmt" {
 			preDivide = 1.0e6
-		} else if opt == "--nanos" {
+		} else if opt == "--nanos" || opt == "--nanos2gmt" {
 			preDivide = 1.0e9
 
 		} else {
@@ -159,12 +162,8 @@ func (tr *TransformerSec2GMT) Transform(
 		for _, fieldName := range tr.fieldNameList {
 			value := inrec.Get(fieldName)
 			if value != nil {
-				floatval, ok := value.GetNumericToFloatValue()
-				if ok {
-					newValue := mlrval.FromString(lib.Sec2GMT(
-						floatval/tr.preDivide,
-						tr.numDecimalPlaces,
-					))
+				newValue := tr.sec2GMT(value)
+				if newValue != nil {
 					inrec.PutReference(fieldName, newValue)
 				}
 			}
@@ -175,3 +174,30 @@ func (tr *TransformerSec2GMT) Transform(
 		outputRecordsAndContexts.PushBack(inrecAndContext) // end-of-stream marker
 	}
 }
+
+// sec2GMT returns nil for non-numeric input. Integer milliseconds,
+// microseconds, or nanoseconds are scaled to nanoseconds in integer
+// arithmetic, since dividing them down to float seconds loses the low-order
+// digits. Integers too large to scale to nanoseconds without overflow use
+// float seconds instead.
+func (tr *TransformerSec2GMT) sec2GMT(value *mlrval.Mlrval) *mlrval.Mlrval {
+	if tr.preDivide != 1.0 {
+		intval, ok := value.GetIntValue()
+		multiplier := int64(1.0e9 / tr.preDivide)
+		if ok && intval <= math.MaxInt64/multiplier && intval >= math.MinInt64/multiplier {
+			return mlrval.FromString(lib.Nsec2GMT(
+				intval*multiplier,
+				tr.numDecimalPlaces,
+			))
+		}
+	}
+
+	floatval, ok := value.GetNumericToFloatValue()
+	if !ok {
+		return nil
+	}
+	return mlrval.FromString(lib.Sec2GMT(
+		floatval/tr.preDivide,
+		tr.numDecimalPlaces,
+	))
+}
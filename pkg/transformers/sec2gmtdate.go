@@ -24,13 +24,13 @@ var Sec2GMTDateSetup = TransformerSetup{
 func transformerSec2GMTDateUsage(
 	o *os.File,
 ) {
-	fmt.Fprintf(o, "Usage: ../c/mlr sec2gmtdate {comma-separated list of field names}\n")
+	fmt.Fprintf(o, "Usage: %s %s {comma-separated list of field names}\n", "mlr", verbNameSec2GMTDate)
 	fmt.Fprintf(o, "Replaces a numeric field representing seconds since the epoch with the\n")
 	fmt.Fprintf(o, "corresponding GMT year-month-day timestamp; leaves non-numbers as-is.\n")
 	fmt.Fprintf(o, "This is nothing more than a keystroke-saver for the sec2gmtdate function:\n")
-	fmt.Fprintf(o, "  ../c/mlr sec2gmtdate time1,time2\n")
+	fmt.Fprintf(o, "  %s %s time1,time2\n", "mlr", verbNameSec2GMTDate)
 	fmt.Fprintf(o, "is the same as\n")
-	fmt.Fprintf(o, "  ../c/mlr put '$time1=sec2gmtdate($time1);$time2=sec2gmtdate($time2)'\n")
+	fmt.Fprintf(o, "  %s put '$time1=sec2gmtdate($time1);$time2=sec2gmtdate($time2)'\n", "mlr")
 }
 
 func transformerSec2GMTDateParseCLI(
@@ -26,7 +26,6 @@ func transformerSeqgenUsage(
 	o *os.File,
 ) {
 	fmt.Fprintf(o, "Usage: %s %s [options]\n", "mlr", verbNameSeqgen)
-	fmt.Fprintf(o, "Passes input records directly to output. Most useful for format conversion.\n")
 	fmt.Fprintf(o, "Produces a sequence of counters.  Discards the input record stream. Produces\n")
 	fmt.Fprintf(o, "output as specified by the options\n")
 	fmt.Fprintf(o, "\n")
@@ -176,7 +175,10 @@ func (tr *TransformerSeqgen) Transform(
 	inputDownstreamDoneChannel <-chan bool,
 	outputDownstreamDoneChannel chan<- bool,
 ) {
+	// The counter is computed as start + k * step, rather than by repeatedly adding step, so
+	// that floating-point steps don't accumulate roundoff error and miss the stop value.
 	counter := tr.start
+	k := mlrval.FromInt(0)
 	context := types.NewNilContext()
 	context.UpdateForStartOfFile("seqgen")
 
@@ -214,7 +216,8 @@ func (tr *TransformerSeqgen) Transform(
 		outrecAndContext := types.NewRecordAndContext(outrec, context)
 		outputRecordsAndContexts.PushBack(outrecAndContext)
 
-		counter = bifs.BIF_plus_binary(counter, tr.step)
+		k = bifs.BIF_plus_binary(k, mlrval.ONE)
+		counter = bifs.BIF_plus_binary(tr.start, bifs.BIF_times(k, tr.step))
 	}
 
 	outputRecordsAndContexts.PushBack(types.NewEndOfStreamMarker(context))
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/johnkerl/miller/pkg/cli"
+	"github.com/johnkerl/miller/pkg/lib"
 	"github.com/johnkerl/miller/pkg/types"
 )
 
@@ -27,6 +28,8 @@ func transformerSortWithinRecordsUsage(
 	fmt.Fprintln(o, "Outputs records sorted lexically ascending by keys.")
 	fmt.Fprintf(o, "Options:\n")
 	fmt.Fprintf(o, "-r        Recursively sort subobjects/submaps, e.g. for JSON input.\n")
+	fmt.Fprintf(o, "-t        Natural sort, with embedded numbers compared numerically, so f2 is before f10.\n")
+	fmt.Fprintf(o, "-d        Sort descending rather than ascending.\n")
 	fmt.Fprintf(o, "-h|--help Show this message.\n")
 }
 
@@ -42,6 +45,8 @@ func transformerSortWithinRecordsParseCLI(
 	argi := *pargi
 	argi++
 	doRecurse := false
+	doNatural := false
+	doDescending := false
 
 	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
 		opt := args[argi]
@@ -60,21 +65,24 @@ func transformerSortWithinRecordsParseCLI(
 		} else if opt == "-r" {
 			doRecurse = true
 
+		} else if opt == "-t" {
+			doNatural = true
+
+		} else if opt == "-d" {
+			doDescending = true
+
 		} else {
 			transformerSortWithinRecordsUsage(os.Stderr)
 			os.Exit(1)
 		}
 	}
 
-	// TODO: allow sort by key or value?
-	// TODO: allow sort ascendending/descending?
-
 	*pargi = argi
 	if !doConstruct { // All transformers must do this for main command-line parsing
 		return nil
 	}
 
-	transformer, err := NewTransformerSortWithinRecords(doRecurse)
+	transformer, err := NewTransformerSortWithinRecords(doRecurse, doNatural, doDescending)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
@@ -85,18 +93,31 @@ func transformerSortWithinRecordsParseCLI(
 
 // ----------------------------------------------------------------
 type TransformerSortWithinRecords struct {
-	recordTransformerFunc RecordTransformerFunc
+	sortKeys  func(keys []string)
+	doRecurse bool
 }
 
 func NewTransformerSortWithinRecords(
 	doRecurse bool,
+	doNatural bool,
+	doDescending bool,
 ) (*TransformerSortWithinRecords, error) {
 
-	tr := &TransformerSortWithinRecords{}
-	if doRecurse {
-		tr.recordTransformerFunc = tr.transformRecursively
-	} else {
-		tr.recordTransformerFunc = tr.transformNonrecursively
+	sortAscending := lib.SortStrings
+	if doNatural {
+		sortAscending = lib.SortStringsNaturally
+	}
+	sortKeys := sortAscending
+	if doDescending {
+		sortKeys = func(keys []string) {
+			sortAscending(keys)
+			lib.ReverseStringList(keys)
+		}
+	}
+
+	tr := &TransformerSortWithinRecords{
+		sortKeys:  sortKeys,
+		doRecurse: doRecurse,
 	}
 
 	return tr, nil
@@ -111,33 +132,9 @@ func (tr *TransformerSortWithinRecords) Transform(
 	outputDownstreamDoneChannel chan<- bool,
 ) {
 	HandleDefaultDownstreamDone(inputDownstreamDoneChannel, outputDownstreamDoneChannel)
-	tr.recordTransformerFunc(inrecAndContext, outputRecordsAndContexts, inputDownstreamDoneChannel, outputDownstreamDoneChannel)
-}
-
-// ----------------------------------------------------------------
-func (tr *TransformerSortWithinRecords) transformNonrecursively(
-	inrecAndContext *types.RecordAndContext,
-	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
-	inputDownstreamDoneChannel <-chan bool,
-	outputDownstreamDoneChannel chan<- bool,
-) {
-	if !inrecAndContext.EndOfStream {
-		inrec := inrecAndContext.Record
-		inrec.SortByKey()
-	}
-	outputRecordsAndContexts.PushBack(inrecAndContext) // including end-of-stream marker
-}
-
-// ----------------------------------------------------------------
-func (tr *TransformerSortWithinRecords) transformRecursively(
-	inrecAndContext *types.RecordAndContext,
-	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
-	inputDownstreamDoneChannel <-chan bool,
-	outputDownstreamDoneChannel chan<- bool,
-) {
 	if !inrecAndContext.EndOfStream {
 		inrec := inrecAndContext.Record
-		inrec.SortByKeyRecursively()
+		inrec.SortByKeyWith(tr.sortKeys, tr.doRecurse)
 	}
 	outputRecordsAndContexts.PushBack(inrecAndContext) // including end-of-stream marker
 }
@@ -39,7 +39,9 @@ Exactly one  of -m, -n, or -g must be supplied.
 --suffix {s} Specify filename suffix; default is from mlr output format, e.g. "csv".
 -a           Append to existing file(s), if any, rather than overwriting.
 -v           Send records along to downstream verbs as well as splitting to files.
--e           Do NOT URL-escape names of output files.
+-e           Do NOT URL-escape names of output files. Slashes and backslashes in
+             field values are still escaped, so that values such as "../x" can't
+             place files outside the directory given by --prefix.
 -j {J}       Use string J to join filename parts; default "`+splitDefaultFileNamePartJoiner+`".
 -h|--help    Show this message.
 Any of the output-format command-line flags (see mlr -h). For example, using
@@ -416,6 +418,11 @@ func (tr *TransformerSplit) makeUngroupedOutputFileName(k int64) string {
 	return fmt.Sprintf("%s_%d.%s", tr.outputFileNamePrefix, k, tr.outputFileNameSuffix)
 }
 
+// splitPathSeparatorEscaper is for split -e: field values are used in output
+// file names as-is, except for path separators. Otherwise a value like
+// "../../x" would write outside of the --prefix directory.
+var splitPathSeparatorEscaper = strings.NewReplacer("/", "%2F", "\\", "%5C")
+
 // makeGroupedOutputFileName example: "split_orange.csv"
 func (tr *TransformerSplit) makeGroupedOutputFileName(
 	groupByFieldValues []*mlrval.Mlrval,
@@ -423,7 +430,11 @@ func (tr *TransformerSplit) makeGroupedOutputFileName(
 	var fileNameParts []string
 
 	for _, groupByFieldValue := range groupByFieldValues {
-		fileNameParts = append(fileNameParts, groupByFieldValue.String())
+		fileNamePart := groupByFieldValue.String()
+		if !tr.escapeFileNameCharacters {
+			fileNamePart = splitPathSeparatorEscaper.Replace(fileNamePart)
+		}
+		fileNameParts = append(fileNameParts, fileNamePart)
 	}
 
 	fileName := strings.Join(fileNameParts, tr.fileNamePartJoiner)
@@ -36,6 +36,7 @@ Options:
 -a {sum,count,...} Names of accumulators: one or more of:
   median   This is the same as p50
   p10 p25.2 p50 p98 p100 etc.
+  iqr      Interquartile range: p75 minus p25
 `)
 	utils.ListStats1Accumulators(o)
 	fmt.Fprint(o, `
@@ -53,8 +54,8 @@ Options:
 
 --grfx {regex} Shorthand for --gr {regex} --fx {that same regex}
 
--i             Use interpolated percentiles, like R's type=7; default like type=1.
-               Not sensical for string-valued fields.\n");
+-i|--interpolate Use linearly interpolated percentiles; see the notes below.
+               Not sensical for string-valued fields.
 -s             Print iterative stats. Useful in tail -f contexts, in which
                case please avoid pprint-format output since end of input
 `)
@@ -77,14 +78,23 @@ Options:
 	fmt.Fprint(o,
 		`Notes:
 * p50 and median are synonymous.
+* Percentiles are computed from the sorted values x[0] through x[n-1]. By
+  default pN is x[int(N*n/100)], or x[n-1] if that is past the end. With -i,
+  pN is x[i] + f*(x[i+1]-x[i]) where i and f are the integer and fractional
+  parts of N*(n-1)/100, as in numpy's default and R's type=7.
 * min and max output the same results as p0 and p100, respectively, but use
   less memory.
 * String-valued data make sense unless arithmetic on them is required,
   e.g. for sum, mean, interpolated percentiles, etc. In case of mixed data,
   numbers are less than strings.
-* count and mode allow text input; the rest require numeric input.
-  In particular, 1 and 1.0 are distinct text for count and mode.
-* When there are mode ties, the first-encountered datum wins.
+* count, distinct_count, mode, antimode, first, and last allow text input; the
+  rest require numeric input. In particular, 1 and 1.0 are distinct text for
+  distinct_count, mode, and antimode.
+* Empty and JSON-null values are counted by null_count, and skipped by the
+  other accumulators. Records lacking the field are counted by neither count
+  nor null_count.
+* When there are mode or antimode ties, the first-encountered datum wins. For
+  example, the values b,a,a,b have mode b and antimode b.
 `)
 }
 
@@ -159,7 +169,7 @@ func transformerStats1ParseCLI(
 			valueFieldNameList = cli.VerbGetStringArrayArgOrDie(verb, opt, args, &argi, argc)
 			groupByFieldNameList = lib.CopyStringArray(valueFieldNameList)
 
-		} else if opt == "-i" {
+		} else if opt == "-i" || opt == "--interpolate" {
 			doInterpolatedPercentiles = true
 
 		} else if opt == "-s" {
@@ -497,14 +507,6 @@ func (tr *TransformerStats1) ingestWithoutValueFieldRegexes(
 				)
 				level3.(*lib.OrderedMap).Put(accumulatorName, namedAccumulator)
 			}
-			if valueFieldValue.IsVoid() {
-				// The accumulator has been initialized with default values;
-				// continue here. (If we were to continue outside of this loop
-				// we would be failing to construct the accumulator.)
-				if accumulatorName != "null_count" {
-					continue
-				}
-			}
 			namedAccumulator.(*utils.Stats1NamedAccumulator).Ingest(valueFieldValue)
 		}
 	}
@@ -542,12 +544,6 @@ func (tr *TransformerStats1) ingestWithValueFieldRegexes(
 				)
 				level3.(*lib.OrderedMap).Put(accumulatorName, namedAccumulator)
 			}
-			if valueFieldValue.IsVoid() {
-				// The accumulator has been initialized with default values;
-				// continue here. (If we were to continue outside of this loop
-				// we would be failing to construct the accumulator.)
-				continue
-			}
 			namedAccumulator.(*utils.Stats1NamedAccumulator).Ingest(valueFieldValue)
 		}
 	}
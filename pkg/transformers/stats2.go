@@ -341,7 +341,7 @@ func (tr *TransformerStats2) ingest(
 		if mval1 == nil || mval2 == nil { // Key absent in current record
 			continue
 		}
-		if mval1.IsVoid() || mval2.IsVoid() { // Key present in current record but with empty value
+		if !mval1.IsNumeric() || !mval2.IsNumeric() { // Key present in current record but with empty or non-numeric value
 			continue
 		}
 
@@ -458,7 +458,7 @@ func (tr *TransformerStats2) fit(
 					// Note R2, cov, corr, etc have no non-trivial fit-function
 					mval1 := record.Get(valueFieldName1)
 					mval2 := record.Get(valueFieldName2)
-					if mval1 != nil && mval2 != nil {
+					if mval1 != nil && mval2 != nil && mval1.IsNumeric() && mval2.IsNumeric() {
 						accumulator.Fit(
 							mval1.GetNumericToFloatValueOrDie(),
 							mval2.GetNumericToFloatValueOrDie(),
@@ -416,13 +416,48 @@ func (tr *TransformerStep) handleRecord(
 	// Keep a log of delayed-input records, which we'll drain at end of record stream.
 	tr.insertToLog(inrecAndContext, windowKeeper, groupToAccField)
 
-	// E.g. if x=3.4 and y=5.6 then this is [3.4, 5.6]
-	valueFieldValues, _ := inrec.ReferenceSelectedValues(tr.valueFieldNames)
+	tr.processSteppers(windowKeeper, groupToAccField)
+
+	if windowKeeper.Get(0) != nil {
+		outrecAndContext := windowKeeper.Get(0).(*types.RecordAndContext)
+		outputRecordsAndContexts.PushBack(outrecAndContext)
+		tr.removeFromLog(outrecAndContext)
+	}
+}
+
+// handleDrainRecord processes records received after the end of the record stream is seen.  The
+// records emitted here are the ones we couldn't emit before. For example, with shift_lead, if the
+// most recent input record is the 11th, then before EOS we emitted the 10th. Here, we'll drain any
+// delayed-input records in the order in which they were received.
+func (tr *TransformerStep) handleDrainRecord(
+	logEntry *tStepLogEntry,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+) {
+	windowKeeper := logEntry.windowKeeper
+	tr.processSteppers(windowKeeper, logEntry.steppers)
+
+	if windowKeeper.Get(0) != nil {
+		outrecAndContext := windowKeeper.Get(0).(*types.RecordAndContext)
+		outputRecordsAndContexts.PushBack(outrecAndContext)
+	}
+}
+
+// processSteppers runs the steppers over the window's current record. With look-forward steppers
+// such as shift_lead, this is not the most recently ingested record, so field presence is checked
+// on the current record rather than the input record.
+func (tr *TransformerStep) processSteppers(
+	windowKeeper *utils.TWindowKeeper,
+	groupToAccField map[string]map[string]tStepper,
+) {
+	icur := windowKeeper.Get(0)
+	if icur == nil {
+		return
+	}
+	currec := icur.(*types.RecordAndContext).Record
 
 	// For x=3.4 and y=5.6:
-	for i, valueFieldName := range tr.valueFieldNames {
-		valueFieldValue := valueFieldValues[i]
-		if valueFieldValue == nil { // not present in the current record
+	for _, valueFieldName := range tr.valueFieldNames {
+		if !currec.Has(valueFieldName) { // not present in the current record
 			continue
 		}
 
@@ -453,52 +488,6 @@ func (tr *TransformerStep) handleRecord(
 			stepper.process(windowKeeper)
 		}
 	}
-
-	if windowKeeper.Get(0) != nil {
-		outrecAndContext := windowKeeper.Get(0).(*types.RecordAndContext)
-		outputRecordsAndContexts.PushBack(outrecAndContext)
-		tr.removeFromLog(outrecAndContext)
-	}
-}
-
-// handleDrainRecord processes records received after the end of the record stream is seen.  The
-// records emitted here are the ones we couldn't emit before. For example, with shift_lead, if the
-// most recent input record is the 11th, then before EOS we emitted the 10th. Here, we'll drain any
-// delayed-input records in the order in which they were received.
-func (tr *TransformerStep) handleDrainRecord(
-	logEntry *tStepLogEntry,
-	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
-) {
-	inrecAndContext := logEntry.recordAndContext
-	inrec := inrecAndContext.Record
-	windowKeeper := logEntry.windowKeeper
-	steppers := logEntry.steppers
-
-	// [3.4, 5.6]
-	valueFieldValues, _ := inrec.ReferenceSelectedValues(tr.valueFieldNames)
-
-	// for x=3.4 and y=5.6:
-	for i, valueFieldName := range tr.valueFieldNames {
-		valueFieldValue := valueFieldValues[i]
-		if valueFieldValue == nil { // not present in the current record
-			continue
-		}
-
-		accFieldToAccState := steppers[valueFieldName]
-		lib.InternalCodingErrorIf(accFieldToAccState == nil)
-
-		// for "delta", "rsum":
-		for _, stepperInput := range tr.stepperInputs {
-			stepper, present := accFieldToAccState[stepperInput.name]
-			lib.InternalCodingErrorIf(!present)
-			stepper.process(windowKeeper)
-		}
-	}
-
-	if windowKeeper.Get(0) != nil {
-		outrecAndContext := windowKeeper.Get(0).(*types.RecordAndContext)
-		outputRecordsAndContexts.PushBack(outrecAndContext)
-	}
 }
 
 // insertToLog remembers a delayed-input record so we can process it in the order it was received,
@@ -697,6 +686,7 @@ func allocateStepper(
 
 // ================================================================
 type tStepperDelta struct {
+	previous        *mlrval.Mlrval
 	inputFieldName  string
 	outputFieldName string
 }
@@ -706,7 +696,7 @@ func stepperDeltaInputFromName(
 ) *tStepperInput {
 	return &tStepperInput{
 		name:               stepperName,
-		numRecordsBackward: 1,
+		numRecordsBackward: 0, // doesn't use record-windowing; retains its own pointer
 		numRecordsForward:  0,
 	}
 }
@@ -718,6 +708,7 @@ func stepperDeltaAlloc(
 	_unused2 []string,
 ) tStepper {
 	return &tStepperDelta{
+		previous:        nil,
 		inputFieldName:  inputFieldName,
 		outputFieldName: inputFieldName + "_delta",
 	}
@@ -733,6 +724,9 @@ func (stepper *tStepperDelta) process(
 	currecAndContext := icur.(*types.RecordAndContext)
 	currec := currecAndContext.Record
 	currval := currec.Get(stepper.inputFieldName)
+	if currval == nil { // not present in the current record
+		return
+	}
 
 	if currval.IsVoid() {
 		currec.PutCopy(stepper.outputFieldName, mlrval.VOID)
@@ -740,21 +734,17 @@ func (stepper *tStepperDelta) process(
 	}
 
 	delta := mlrval.FromInt(0)
-
-	iprev := windowKeeper.Get(-1)
-	if iprev != nil {
-		prevrec := iprev.(*types.RecordAndContext).Record
-		prevval := prevrec.Get(stepper.inputFieldName)
-		if prevval != nil {
-			delta = bifs.BIF_minus_binary(currval, prevval)
-		}
+	if stepper.previous != nil {
+		delta = bifs.BIF_minus_binary(currval, stepper.previous)
 	}
-	currec.PutCopy(stepper.outputFieldName, delta.Copy())
+	currec.PutCopy(stepper.outputFieldName, delta)
+	stepper.previous = currval.Copy()
 }
 
 // ================================================================
-// shift is an alias for shift
+// shift is an alias for shift_lag
 type tStepperShiftLag struct {
+	previous        *mlrval.Mlrval
 	inputFieldName  string
 	outputFieldName string
 }
@@ -764,7 +754,7 @@ func stepperShiftInputFromName(
 ) *tStepperInput {
 	return &tStepperInput{
 		name:               stepperName,
-		numRecordsBackward: 1,
+		numRecordsBackward: 0, // doesn't use record-windowing; retains its own pointer
 		numRecordsForward:  0,
 	}
 }
@@ -774,7 +764,7 @@ func stepperShiftLagInputFromName(
 ) *tStepperInput {
 	return &tStepperInput{
 		name:               stepperName,
-		numRecordsBackward: 1,
+		numRecordsBackward: 0, // doesn't use record-windowing; retains its own pointer
 		numRecordsForward:  0,
 	}
 }
@@ -786,6 +776,7 @@ func stepperShiftAlloc(
 	_unused2 []string,
 ) tStepper {
 	return &tStepperShiftLag{
+		previous:        nil,
 		inputFieldName:  inputFieldName,
 		outputFieldName: inputFieldName + "_shift",
 	}
@@ -798,6 +789,7 @@ func stepperShiftLagAlloc(
 	_unused2 []string,
 ) tStepper {
 	return &tStepperShiftLag{
+		previous:        nil,
 		inputFieldName:  inputFieldName,
 		outputFieldName: inputFieldName + "_shift_lag",
 	}
@@ -812,20 +804,17 @@ func (stepper *tStepperShiftLag) process(
 	}
 	currecAndContext := icur.(*types.RecordAndContext)
 	currec := currecAndContext.Record
-
-	iprev := windowKeeper.Get(-1)
-	if iprev == nil {
-		currec.PutCopy(stepper.outputFieldName, mlrval.VOID)
+	currval := currec.Get(stepper.inputFieldName)
+	if currval == nil { // not present in the current record
 		return
 	}
-	prevrec := iprev.(*types.RecordAndContext).Record
-	prevval := prevrec.Get(stepper.inputFieldName)
 
-	if prevval == nil {
+	if stepper.previous == nil {
 		currec.PutCopy(stepper.outputFieldName, mlrval.VOID)
 	} else {
-		currec.PutCopy(stepper.outputFieldName, prevval.Copy())
+		currec.PutCopy(stepper.outputFieldName, stepper.previous)
 	}
+	stepper.previous = currval.Copy()
 }
 
 // ================================================================
@@ -874,7 +863,9 @@ func (stepper *tStepperShiftLead) process(
 	nextrec := inextrec.(*types.RecordAndContext).Record
 	nextval := nextrec.Get(stepper.inputFieldName)
 
-	if nextval != nil {
+	if nextval == nil {
+		currec.PutCopy(stepper.outputFieldName, mlrval.VOID)
+	} else {
 		currec.PutCopy(stepper.outputFieldName, nextval.Copy())
 	}
 }
@@ -919,6 +910,9 @@ func (stepper *tStepperFromFirst) process(
 	currecAndContext := icur.(*types.RecordAndContext)
 	currec := currecAndContext.Record
 	currval := currec.Get(stepper.inputFieldName)
+	if currval == nil { // not present in the current record
+		return
+	}
 
 	fromFirst := mlrval.FromInt(0)
 	if stepper.first == nil {
@@ -931,6 +925,7 @@ func (stepper *tStepperFromFirst) process(
 
 // ================================================================
 type tStepperRatio struct {
+	previous        *mlrval.Mlrval
 	inputFieldName  string
 	outputFieldName string
 }
@@ -940,7 +935,7 @@ func stepperRatioInputFromName(
 ) *tStepperInput {
 	return &tStepperInput{
 		name:               stepperName,
-		numRecordsBackward: 1,
+		numRecordsBackward: 0, // doesn't use record-windowing; retains its own pointer
 		numRecordsForward:  0,
 	}
 }
@@ -952,6 +947,7 @@ func stepperRatioAlloc(
 	_unused2 []string,
 ) tStepper {
 	return &tStepperRatio{
+		previous:        nil,
 		inputFieldName:  inputFieldName,
 		outputFieldName: inputFieldName + "_ratio",
 	}
@@ -967,6 +963,9 @@ func (stepper *tStepperRatio) process(
 	currecAndContext := icur.(*types.RecordAndContext)
 	currec := currecAndContext.Record
 	currval := currec.Get(stepper.inputFieldName)
+	if currval == nil { // not present in the current record
+		return
+	}
 
 	if currval.IsVoid() {
 		currec.PutCopy(stepper.outputFieldName, mlrval.VOID)
@@ -974,16 +973,11 @@ func (stepper *tStepperRatio) process(
 	}
 
 	ratio := mlrval.FromInt(1)
-
-	iprev := windowKeeper.Get(-1)
-	if iprev != nil {
-		prevrec := iprev.(*types.RecordAndContext).Record
-		prevval := prevrec.Get(stepper.inputFieldName)
-		if prevval != nil {
-			ratio = bifs.BIF_divide(currval, prevval)
-		}
+	if stepper.previous != nil {
+		ratio = bifs.BIF_divide(currval, stepper.previous)
 	}
-	currec.PutCopy(stepper.outputFieldName, ratio.Copy())
+	currec.PutCopy(stepper.outputFieldName, ratio)
+	stepper.previous = currval.Copy()
 }
 
 // ================================================================
@@ -1026,6 +1020,9 @@ func (stepper *tStepperRprod) process(
 	currecAndContext := icur.(*types.RecordAndContext)
 	currec := currecAndContext.Record
 	currval := currec.Get(stepper.inputFieldName)
+	if currval == nil { // not present in the current record
+		return
+	}
 
 	if currval.IsVoid() {
 		currec.PutCopy(stepper.outputFieldName, mlrval.VOID)
@@ -1075,6 +1072,9 @@ func (stepper *tStepperRsum) process(
 	currecAndContext := icur.(*types.RecordAndContext)
 	currec := currecAndContext.Record
 	currval := currec.Get(stepper.inputFieldName)
+	if currval == nil { // not present in the current record
+		return
+	}
 
 	if currval.IsVoid() {
 		currec.PutCopy(stepper.outputFieldName, mlrval.VOID)
@@ -1124,6 +1124,9 @@ func (stepper *tStepperCounter) process(
 	currecAndContext := icur.(*types.RecordAndContext)
 	currec := currecAndContext.Record
 	currval := currec.Get(stepper.inputFieldName)
+	if currval == nil { // not present in the current record
+		return
+	}
 
 	if currval.IsVoid() {
 		currec.PutCopy(stepper.outputFieldName, mlrval.VOID)
@@ -1214,6 +1217,16 @@ func (stepper *tStepperEWMA) process(
 	currecAndContext := icur.(*types.RecordAndContext)
 	currec := currecAndContext.Record
 	currval := currec.Get(stepper.inputFieldName)
+	if currval == nil { // not present in the current record
+		return
+	}
+
+	if currval.IsVoid() {
+		for i := range stepper.alphas {
+			currec.PutCopy(stepper.outputFieldNames[i], mlrval.VOID)
+		}
+		return
+	}
 
 	if !stepper.havePrevs {
 		for i := range stepper.alphas {
@@ -1302,7 +1315,7 @@ func (stepper *tStepperSlwin) process(
 		rac := irac.(*types.RecordAndContext)
 		rec := rac.Record
 		val := rec.Get(stepper.inputFieldName)
-		if val.IsVoid() {
+		if val == nil || val.IsVoid() {
 			continue
 		}
 		sum = bifs.BIF_plus_binary(sum, val)
@@ -7,7 +7,6 @@ import (
 	"regexp"
 	"strings"
 
-	"github.com/johnkerl/miller/pkg/bifs"
 	"github.com/johnkerl/miller/pkg/cli"
 	"github.com/johnkerl/miller/pkg/lib"
 	"github.com/johnkerl/miller/pkg/mlrval"
@@ -43,42 +42,53 @@ var SsubSetup = TransformerSetup{
 func transformerSubUsage(
 	o *os.File,
 ) {
-	fmt.Fprintf(o, "Usage: %s %s [options]\n", "mlr", verbNameSub)
+	fmt.Fprintf(o, "Usage: %s %s [options] {old} {new}\n", "mlr", verbNameSub)
 	fmt.Fprintf(o, "Replaces old string with new string in specified field(s), with regex support\n")
 	fmt.Fprintf(o, "for the old string and not handling multiple matches, like the `sub` DSL function.\n")
-	fmt.Fprintf(o, "See also the `gsub` and `ssub` verbs.\n")
-	fmt.Fprintf(o, "Options:\n")
-	fmt.Fprintf(o, "-f {a,b,c}  Field names to convert.\n")
-	fmt.Fprintf(o, "-h|--help   Show this message.\n")
+	fmt.Fprintf(o, "Captures \"\\1\" through \"\\9\" in the new string refer to parenthesized\n")
+	fmt.Fprintf(o, "parts of the old-string regex. See also the `gsub` and `ssub` verbs.\n")
+	transformerSubsUsageOptions(o)
 }
 
 func transformerGsubUsage(
 	o *os.File,
 ) {
-	fmt.Fprintf(o, "Usage: %s %s [options]\n", "mlr", verbNameGsub)
+	fmt.Fprintf(o, "Usage: %s %s [options] {old} {new}\n", "mlr", verbNameGsub)
 	fmt.Fprintf(o, "Replaces old string with new string in specified field(s), with regex support\n")
 	fmt.Fprintf(o, "for the old string and handling multiple matches, like the `gsub` DSL function.\n")
-	fmt.Fprintf(o, "See also the `sub` and `ssub` verbs.\n")
-	fmt.Fprintf(o, "Options:\n")
-	fmt.Fprintf(o, "-f {a,b,c}  Field names to convert.\n")
-	fmt.Fprintf(o, "-h|--help   Show this message.\n")
+	fmt.Fprintf(o, "Captures \"\\1\" through \"\\9\" in the new string refer to parenthesized\n")
+	fmt.Fprintf(o, "parts of the old-string regex. See also the `sub` and `ssub` verbs.\n")
+	transformerSubsUsageOptions(o)
 }
 
 func transformerSsubUsage(
 	o *os.File,
 ) {
-	fmt.Fprintf(o, "Usage: %s %s [options]\n", "mlr", verbNameSsub)
+	fmt.Fprintf(o, "Usage: %s %s [options] {old} {new}\n", "mlr", verbNameSsub)
 	fmt.Fprintf(o, "Replaces old string with new string in specified field(s), without regex support for\n")
 	fmt.Fprintf(o, "the old string, like the `ssub` DSL function. See also the `gsub` and `sub` verbs.\n")
+	transformerSubsUsageOptions(o)
+}
+
+// transformerSubsUsageOptions is shared usage for the sub, gsub, and ssub verbs.
+func transformerSubsUsageOptions(
+	o *os.File,
+) {
 	fmt.Fprintf(o, "Options:\n")
-	fmt.Fprintf(o, "-f {a,b,c}  Field names to convert.\n")
-	fmt.Fprintf(o, "-h|--help   Show this message.\n")
+	fmt.Fprintf(o, "-f {a,b,c}      Field names to convert.\n")
+	fmt.Fprintf(o, "-r              Treat -f field names as regular expressions. \"abc\"i is\n")
+	fmt.Fprintf(o, "                case-insensitive.\n")
+	fmt.Fprintf(o, "-a              Convert all fields.\n")
+	fmt.Fprintf(o, "--skip-numeric  Leave numeric field values as-is. By default they are\n")
+	fmt.Fprintf(o, "                converted as strings.\n")
+	fmt.Fprintf(o, "-h|--help       Show this message.\n")
 }
 
 type subConstructorFunc func(
 	fieldNames []string,
 	doAllFieldNames bool,
 	doRegexes bool,
+	skipNumeric bool,
 	oldText string,
 	newText string,
 ) (IRecordTransformer, error)
@@ -137,6 +147,7 @@ func transformerSubsParseCLI(
 	var fieldNames []string = nil
 	doAllFieldNames := false
 	doRegexes := false
+	skipNumeric := false
 	var oldText string
 	var newText string
 
@@ -162,6 +173,9 @@ func transformerSubsParseCLI(
 		} else if opt == "-r" {
 			doRegexes = true
 
+		} else if opt == "--skip-numeric" {
+			skipNumeric = true
+
 		} else if opt == "-f" {
 			fieldNames = cli.VerbGetStringArrayArgOrDie(verb, opt, args, &argi, argc)
 			doAllFieldNames = false
@@ -195,6 +209,7 @@ func transformerSubsParseCLI(
 		fieldNames,
 		doAllFieldNames,
 		doRegexes,
+		skipNumeric,
 		oldText,
 		newText,
 	)
@@ -206,58 +221,87 @@ func transformerSubsParseCLI(
 	return transformer
 }
 
+// subberFunc does the sub, gsub, or ssub on a single string value.
+type subberFunc func(input string) string
+
 type TransformerSubs struct {
-	fieldNamesSet map[string]bool  // for -f
-	regexes       []*regexp.Regexp // for -r
-	oldText       *mlrval.Mlrval
-	newText       *mlrval.Mlrval
+	fieldNamesSet map[string]bool   // for -f
+	regexes       []*regexp.Regexp  // for -r
 	fieldAcceptor fieldAcceptorFunc // for -f, -r, -a
-	subber        bifs.TernaryFunc  // for sub, gsub, ssub
+	skipNumeric   bool
+
+	// The old text is compiled once here, not once per record
+	oldRegex                 *regexp.Regexp // for sub and gsub
+	oldText                  string         // for ssub
+	newText                  string
+	replacementCaptureMatrix [][]int
+	subber                   subberFunc // for sub, gsub, ssub
 }
 
 func NewTransformerSub(
 	fieldNames []string,
 	doAllFieldNames bool,
 	doRegexes bool,
+	skipNumeric bool,
 	oldText string,
 	newText string,
 ) (IRecordTransformer, error) {
-	return NewTransformerSubs(fieldNames, doAllFieldNames, doRegexes, oldText, newText, safe_sub)
+	tr, err := NewTransformerSubs(verbNameSub, fieldNames, doAllFieldNames, doRegexes, skipNumeric, oldText, newText)
+	if err != nil {
+		return nil, err
+	}
+	tr.subber = tr.subRegex
+	return tr, nil
 }
 
 func NewTransformerGsub(
 	fieldNames []string,
 	doAllFieldNames bool,
 	doRegexes bool,
+	skipNumeric bool,
 	oldText string,
 	newText string,
 ) (IRecordTransformer, error) {
-	return NewTransformerSubs(fieldNames, doAllFieldNames, doRegexes, oldText, newText, safe_gsub)
+	tr, err := NewTransformerSubs(verbNameGsub, fieldNames, doAllFieldNames, doRegexes, skipNumeric, oldText, newText)
+	if err != nil {
+		return nil, err
+	}
+	tr.subber = tr.gsubRegex
+	return tr, nil
 }
 
 func NewTransformerSsub(
 	fieldNames []string,
 	doAllFieldNames bool,
 	doRegexes bool,
+	skipNumeric bool,
 	oldText string,
 	newText string,
 ) (IRecordTransformer, error) {
-	return NewTransformerSubs(fieldNames, doAllFieldNames, doRegexes, oldText, newText, safe_ssub)
+	tr, err := NewTransformerSubs(verbNameSsub, fieldNames, doAllFieldNames, doRegexes, skipNumeric, oldText, newText)
+	if err != nil {
+		return nil, err
+	}
+	tr.subber = tr.ssubLiteral
+	return tr, nil
 }
 
+// NewTransformerSubs is shared construction for the sub, gsub, and ssub verbs. The caller sets the
+// subber.
 func NewTransformerSubs(
+	verbName string,
 	fieldNames []string,
 	doAllFieldNames bool,
 	doRegexes bool,
+	skipNumeric bool,
 	oldText string,
 	newText string,
-	subber bifs.TernaryFunc,
-) (IRecordTransformer, error) {
+) (*TransformerSubs, error) {
 	tr := &TransformerSubs{
 		fieldNamesSet: lib.StringListToSet(fieldNames),
-		oldText:       mlrval.FromString(oldText),
-		newText:       mlrval.FromString(newText),
-		subber:        subber,
+		skipNumeric:   skipNumeric,
+		oldText:       oldText,
+		newText:       newText,
 	}
 	if doAllFieldNames {
 		tr.fieldAcceptor = tr.fieldAcceptorAll
@@ -269,14 +313,23 @@ func NewTransformerSubs(
 			// Handles "a.*b"i Miller case-insensitive-regex specification
 			regex, err := lib.CompileMillerRegex(regexString)
 			if err != nil {
-				fmt.Fprintf(os.Stderr, "%s %s: cannot compile regex [%s]\n", "mlr", verbNameCut, regexString)
-				os.Exit(1)
+				return nil, fmt.Errorf("mlr %s: cannot compile regex [%s]", verbName, regexString)
 			}
 			tr.regexes[i] = regex
 		}
 	} else {
 		tr.fieldAcceptor = tr.fieldAcceptorByNames
 	}
+
+	if verbName != verbNameSsub {
+		oldRegex, err := lib.CompileMillerRegex(oldText)
+		if err != nil {
+			return nil, fmt.Errorf("mlr %s: cannot compile regex [%s]", verbName, oldText)
+		}
+		tr.oldRegex = oldRegex
+		_, tr.replacementCaptureMatrix = lib.ReplacementHasCaptures(newText)
+	}
+
 	return tr, nil
 }
 
@@ -292,8 +345,17 @@ func (tr *TransformerSubs) Transform(
 		inrec := inrecAndContext.Record
 		// Run sub, gsub, or ssub on the user-specified field names
 		for pe := inrec.Head; pe != nil; pe = pe.Next {
-			if tr.fieldAcceptor(pe.Key) {
-				pe.Value = tr.subber(pe.Value, tr.oldText, tr.newText)
+			if !tr.fieldAcceptor(pe.Key) {
+				continue
+			}
+			// Numbers are converted as strings unless --skip-numeric; booleans, maps, etc. are
+			// left as-is.
+			if pe.Value.IsStringOrVoid() || (pe.Value.IsNumeric() && !tr.skipNumeric) {
+				input := pe.Value.OriginalString()
+				output := tr.subber(input)
+				if output != input {
+					pe.Value = mlrval.FromString(output)
+				}
 			}
 		}
 	}
@@ -327,29 +389,17 @@ func (tr *TransformerSubs) fieldAcceptorAll(
 	return true
 }
 
-// safe_sub implements sub, but doesn't produce error-type on non-string input.
-func safe_sub(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
-	if input1.IsString() {
-		return bifs.BIF_sub(input1, input2, input3)
-	} else {
-		return input1
-	}
+// subRegex implements sub
+func (tr *TransformerSubs) subRegex(input string) string {
+	return lib.RegexCompiledSub(input, tr.oldRegex, tr.newText, tr.replacementCaptureMatrix)
 }
 
-// safe_gsub implements gsub, but doesn't produce error-type on non-string input.
-func safe_gsub(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
-	if input1.IsString() {
-		return bifs.BIF_gsub(input1, input2, input3)
-	} else {
-		return input1
-	}
+// gsubRegex implements gsub
+func (tr *TransformerSubs) gsubRegex(input string) string {
+	return lib.RegexCompiledGsub(input, tr.oldRegex, tr.newText, tr.replacementCaptureMatrix)
 }
 
-// safe_ssub implements ssub, but doesn't produce error-type on non-string input.
-func safe_ssub(input1, input2, input3 *mlrval.Mlrval) *mlrval.Mlrval {
-	if input1.IsString() {
-		return bifs.BIF_ssub(input1, input2, input3)
-	} else {
-		return input1
-	}
+// ssubLiteral implements ssub
+func (tr *TransformerSubs) ssubLiteral(input string) string {
+	return strings.Replace(input, tr.oldText, tr.newText, 1)
 }
@@ -1,12 +1,17 @@
 package transformers
 
 import (
+	"bufio"
 	"container/list"
+	"encoding/gob"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
 	"github.com/johnkerl/miller/pkg/cli"
+	"github.com/johnkerl/miller/pkg/lib"
+	"github.com/johnkerl/miller/pkg/mlrval"
 	"github.com/johnkerl/miller/pkg/types"
 )
 
@@ -26,7 +31,15 @@ func transformerTacUsage(
 	fmt.Fprintf(o, "Usage: %s %s [options]\n", "mlr", verbNameTac)
 	fmt.Fprintf(o, "Prints records in reverse order from the order in which they were encountered.\n")
 	fmt.Fprintf(o, "Options:\n")
-	fmt.Fprintf(o, "-h|--help Show this message.\n")
+	fmt.Fprintf(o, "-g {a,b,c}  Optional group-by-field names. Records are reversed within each\n")
+	fmt.Fprintf(o, "            group, and groups are output in the order they were first seen.\n")
+	fmt.Fprintf(o, "            Records lacking any of the group-by fields are dropped.\n")
+	fmt.Fprintf(o, "--spill {n} Once more than about n bytes of records are held in memory, write\n")
+	fmt.Fprintf(o, "            them to a temporary file, to be read back in reverse order at end\n")
+	fmt.Fprintf(o, "            of stream. This is for inputs too large to fit in memory. The\n")
+	fmt.Fprintf(o, "            temporary file is created in $TMPDIR, or /tmp if that is unset,\n")
+	fmt.Fprintf(o, "            and is removed when done. Not supported with -g.\n")
+	fmt.Fprintf(o, "-h|--help   Show this message.\n")
 }
 
 func transformerTacParseCLI(
@@ -37,8 +50,12 @@ func transformerTacParseCLI(
 	doConstruct bool, // false for first pass of CLI-parse, true for second pass
 ) IRecordTransformer {
 
+	spillThreshold := int64(0)
+	var groupByFieldNames []string = nil
+
 	// Skip the verb name from the current spot in the mlr command line
 	argi := *pargi
+	verb := args[argi]
 	argi++
 
 	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
@@ -55,18 +72,33 @@ func transformerTacParseCLI(
 			transformerTacUsage(os.Stdout)
 			os.Exit(0)
 
+		} else if opt == "-g" {
+			groupByFieldNames = cli.VerbGetStringArrayArgOrDie(verb, opt, args, &argi, argc)
+
+		} else if opt == "--spill" {
+			spillThreshold = cli.VerbGetIntArgOrDie(verb, opt, args, &argi, argc)
+			if spillThreshold <= 0 {
+				transformerTacUsage(os.Stderr)
+				os.Exit(1)
+			}
+
 		} else {
 			transformerTacUsage(os.Stderr)
 			os.Exit(1)
 		}
 	}
 
+	if groupByFieldNames != nil && spillThreshold > 0 {
+		fmt.Fprintf(os.Stderr, "mlr %s: -g and --spill cannot be used together.\n", verb)
+		os.Exit(1)
+	}
+
 	*pargi = argi
 	if !doConstruct { // All transformers must do this for main command-line parsing
 		return nil
 	}
 
-	transformer, err := NewTransformerTac()
+	transformer, err := NewTransformerTac(groupByFieldNames, spillThreshold)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
@@ -78,14 +110,55 @@ func transformerTacParseCLI(
 // ----------------------------------------------------------------
 type TransformerTac struct {
 	recordsAndContexts *list.List
+
+	// For -g: map from grouping key to *list.List, each most recent first
+	groupByFieldNames  []string
+	recordListsByGroup *lib.OrderedMap
+
+	// For --spill: zero means keep everything in memory
+	spillThreshold   int64
+	bytesInMemory    int64
+	spillFile        *os.File
+	spillFileRemoved bool
+	spillChunks      []tTacSpillChunk
+	outputFlusher    func(outputRecordsAndContexts *list.List)
 }
 
-func NewTransformerTac() (*TransformerTac, error) {
+// Each spill writes the records in memory, most recent first, as a
+// self-contained run of gobs, so that the chunks can be read back in the
+// reverse of the order they were written.
+type tTacSpillChunk struct {
+	offset int64
+	length int64
+	count  int
+}
+
+type tTacSpillRecord struct {
+	Context types.Context
+	Record  *mlrval.GobMlrmap
+}
+
+// At end of stream, records read back from the spill file are sent downstream
+// in batches of this size.
+const tacSpillOutputBatchSize = 500
+
+func NewTransformerTac(
+	groupByFieldNames []string,
+	spillThreshold int64,
+) (*TransformerTac, error) {
 	return &TransformerTac{
 		recordsAndContexts: list.New(),
+		groupByFieldNames:  groupByFieldNames,
+		recordListsByGroup: lib.NewOrderedMap(),
+		spillThreshold:     spillThreshold,
 	}, nil
 }
 
+// SetOutputFlusher implements IOutputFlushable.
+func (tr *TransformerTac) SetOutputFlusher(flush func(outputRecordsAndContexts *list.List)) {
+	tr.outputFlusher = flush
+}
+
 func (tr *TransformerTac) Transform(
 	inrecAndContext *types.RecordAndContext,
 	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
@@ -93,13 +166,167 @@ func (tr *TransformerTac) Transform(
 	outputDownstreamDoneChannel chan<- bool,
 ) {
 	HandleDefaultDownstreamDone(inputDownstreamDoneChannel, outputDownstreamDoneChannel)
+	if tr.groupByFieldNames != nil {
+		tr.transformGrouped(inrecAndContext, outputRecordsAndContexts)
+		return
+	}
 	if !inrecAndContext.EndOfStream {
 		tr.recordsAndContexts.PushFront(inrecAndContext)
+		if tr.spillThreshold > 0 {
+			tr.bytesInMemory += tacRecordSize(inrecAndContext.Record)
+			if tr.bytesInMemory >= tr.spillThreshold {
+				tr.dieOnError(tr.spill())
+			}
+		}
 	} else {
 		// end of stream
 		for e := tr.recordsAndContexts.Front(); e != nil; e = e.Next() {
 			outputRecordsAndContexts.PushBack(e.Value.(*types.RecordAndContext))
 		}
+		tr.recordsAndContexts.Init()
+		if tr.spillFile != nil {
+			tr.dieOnError(tr.unspill(outputRecordsAndContexts))
+			tr.removeSpillFile()
+		}
 		outputRecordsAndContexts.PushBack(types.NewEndOfStreamMarker(&inrecAndContext.Context))
 	}
 }
+
+func (tr *TransformerTac) transformGrouped(
+	inrecAndContext *types.RecordAndContext,
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+) {
+	if !inrecAndContext.EndOfStream {
+		groupingKey, ok := inrecAndContext.Record.GetSelectedValuesJoined(tr.groupByFieldNames)
+		if !ok {
+			return
+		}
+
+		irecordListForGroup := tr.recordListsByGroup.Get(groupingKey)
+		if irecordListForGroup == nil { // first time
+			irecordListForGroup = list.New()
+			tr.recordListsByGroup.Put(groupingKey, irecordListForGroup)
+		}
+		irecordListForGroup.(*list.List).PushFront(inrecAndContext)
+
+	} else {
+		for outer := tr.recordListsByGroup.Head; outer != nil; outer = outer.Next {
+			recordListForGroup := outer.Value.(*list.List)
+			for inner := recordListForGroup.Front(); inner != nil; inner = inner.Next() {
+				outputRecordsAndContexts.PushBack(inner.Value.(*types.RecordAndContext))
+			}
+		}
+		tr.recordListsByGroup.Clear()
+		outputRecordsAndContexts.PushBack(inrecAndContext) // end-of-stream marker
+	}
+}
+
+// tacRecordSize is an estimate of the memory used by a record, for --spill.
+func tacRecordSize(record *mlrval.Mlrmap) int64 {
+	size := int64(0)
+	for pe := record.Head; pe != nil; pe = pe.Next {
+		size += int64(len(pe.Key) + len(pe.Value.OriginalString()))
+	}
+	return size
+}
+
+// spill writes the records held in memory to the end of the spill file,
+// creating it if need be.
+func (tr *TransformerTac) spill() error {
+	if tr.spillFile == nil {
+		handle, err := os.CreateTemp("", "mlr-tac-")
+		if err != nil {
+			return err
+		}
+		tr.spillFile = handle
+		// On systems which allow it, remove the file while it's still open, so
+		// that it goes away however the process exits, e.g. on SIGPIPE.
+		// Otherwise it's removed at end of stream or on error.
+		if os.Remove(handle.Name()) == nil {
+			tr.spillFileRemoved = true
+		}
+	}
+
+	offset, err := tr.spillFile.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	bufferedWriter := bufio.NewWriter(tr.spillFile)
+	encoder := gob.NewEncoder(bufferedWriter)
+	count := 0
+	for e := tr.recordsAndContexts.Front(); e != nil; e = e.Next() {
+		recordAndContext := e.Value.(*types.RecordAndContext)
+		err := encoder.Encode(&tTacSpillRecord{
+			Context: recordAndContext.Context,
+			Record:  recordAndContext.Record.ToGob(),
+		})
+		if err != nil {
+			return err
+		}
+		count++
+	}
+	err = bufferedWriter.Flush()
+	if err != nil {
+		return err
+	}
+
+	end, err := tr.spillFile.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	tr.spillChunks = append(tr.spillChunks, tTacSpillChunk{
+		offset: offset,
+		length: end - offset,
+		count:  count,
+	})
+
+	tr.recordsAndContexts.Init()
+	tr.bytesInMemory = 0
+	return nil
+}
+
+// unspill reads the spill file back, last chunk first, sending records
+// downstream in batches if the chain transformer has given us a way to do so.
+func (tr *TransformerTac) unspill(
+	outputRecordsAndContexts *list.List, // list of *types.RecordAndContext
+) error {
+	for i := len(tr.spillChunks) - 1; i >= 0; i-- {
+		chunk := tr.spillChunks[i]
+		decoder := gob.NewDecoder(
+			bufio.NewReader(io.NewSectionReader(tr.spillFile, chunk.offset, chunk.length)),
+		)
+		for j := 0; j < chunk.count; j++ {
+			var spillRecord tTacSpillRecord
+			err := decoder.Decode(&spillRecord)
+			if err != nil {
+				return err
+			}
+			outputRecordsAndContexts.PushBack(
+				types.NewRecordAndContext(spillRecord.Record.ToMlrmap(), &spillRecord.Context),
+			)
+			if tr.outputFlusher != nil && outputRecordsAndContexts.Len() >= tacSpillOutputBatchSize {
+				tr.outputFlusher(outputRecordsAndContexts)
+			}
+		}
+	}
+	return nil
+}
+
+func (tr *TransformerTac) removeSpillFile() {
+	if tr.spillFile != nil {
+		tr.spillFile.Close()
+		if !tr.spillFileRemoved {
+			os.Remove(tr.spillFile.Name())
+		}
+		tr.spillFile = nil
+	}
+}
+
+func (tr *TransformerTac) dieOnError(err error) {
+	if err != nil {
+		tr.removeSpillFile()
+		fmt.Fprintf(os.Stderr, "mlr %s: %v\n", verbNameTac, err)
+		os.Exit(1)
+	}
+}
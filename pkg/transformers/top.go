@@ -33,7 +33,8 @@ func transformerTopUsage(
 	fmt.Fprintf(o, "-f {a,b,c}    Value-field names for top counts.\n")
 	fmt.Fprintf(o, "-g {d,e,f}    Optional group-by-field names for top counts.\n")
 	fmt.Fprintf(o, "-n {count}    How many records to print per category; default 1.\n")
-	fmt.Fprintf(o, "-a            Print all fields for top-value records; default is\n")
+	fmt.Fprintf(o, "-a, --full-records\n")
+	fmt.Fprintf(o, "              Print all fields for top-value records; default is\n")
 	fmt.Fprintf(o, "              to print only value and group-by fields. Requires a single\n")
 	fmt.Fprintf(o, "              value-field name only.\n")
 	fmt.Fprintf(o, "--min         Print top smallest values; default is top largest values.\n")
@@ -44,7 +45,8 @@ func transformerTopUsage(
 	fmt.Fprintf(o, "Prints the n records with smallest/largest values at specified fields,\n")
 	fmt.Fprintf(o, "optionally by category. If -a is given, then the top records are emitted\n")
 	fmt.Fprintf(o, "with the same fields as they appeared in the input. Without -a, only fields\n")
-	fmt.Fprintf(o, "from -f, fields from -g, and the top-index field are emitted. For more information\n")
+	fmt.Fprintf(o, "from -f, fields from -g, and the top-index field are emitted. Empty and\n")
+	fmt.Fprintf(o, "non-numeric values are skipped. Ties are kept in input order. For more information\n")
 	fmt.Fprintf(o, "please see https://miller.readthedocs.io/en/latest/reference-verbs#top\n")
 }
 
@@ -89,7 +91,7 @@ func transformerTopParseCLI(
 			valueFieldNames = cli.VerbGetStringArrayArgOrDie(verb, opt, args, &argi, argc)
 		} else if opt == "-g" {
 			groupByFieldNames = cli.VerbGetStringArrayArgOrDie(verb, opt, args, &argi, argc)
-		} else if opt == "-a" {
+		} else if opt == "-a" || opt == "--full-records" {
 			showFullRecords = true
 		} else if opt == "--max" {
 			doMax = true
@@ -223,6 +225,12 @@ func (tr *TransformerTop) ingest(
 			topKeeper = iTopKeeper.(*utils.TopKeeper)
 		}
 
+		// Empty and non-numeric values aren't ranked, but the group still gets
+		// its (empty) output rows.
+		if !valueFieldValue.IsNumeric() {
+			continue
+		}
+
 		var maybeRecordAndContext *types.RecordAndContext = nil
 		if tr.showFullRecords {
 			maybeRecordAndContext = inrecAndContext
@@ -28,6 +28,10 @@ func transformerUnflattenUsage(
 	fmt.Fprint(o,
 		`Reverses flatten. Example: field with name 'a.b.c' and value 4
 becomes name 'a' and value '{"b": { "c": 4 }}'.
+Maps whose keys are exactly 1, 2, 3, ... in order become arrays: e.g. fields
+'a.1' and 'a.2' with values 5 and 6 become name 'a' and value '[5, 6]'. Other
+keys, such as '1' and '3', leave the map as a map. Values "{}" and "[]" become
+empty map and empty array, respectively.
 `)
 	fmt.Fprintf(o, "Options:\n")
 	fmt.Fprintf(o, "-f {a,b,c} Comma-separated list of field names to unflatten (default all).\n")
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/johnkerl/miller/pkg/bifs"
 	"github.com/johnkerl/miller/pkg/cli"
 	"github.com/johnkerl/miller/pkg/lib"
 	"github.com/johnkerl/miller/pkg/mlrval"
@@ -25,10 +26,12 @@ var UTF8ToLatin1Setup = TransformerSetup{
 func transformerUTF8ToLatin1Usage(
 	o *os.File,
 ) {
-	fmt.Fprintf(o, "Usage: %s %s, with no options.\n", "mlr", verbNameUTF8ToLatin1)
-	fmt.Fprintf(o, "Recursively converts record strings from Latin-1 to UTF-8.\n")
+	fmt.Fprintf(o, "Usage: %s %s [options]\n", "mlr", verbNameUTF8ToLatin1)
+	fmt.Fprintf(o, "Recursively converts record strings from UTF-8 to Latin-1.\n")
 	fmt.Fprintf(o, "For field-level control, please see the utf8_to_latin1 DSL function.\n")
+	fmt.Fprintf(o, "A value with characters not encodable as Latin-1 becomes an error, unless -r is given.\n")
 	fmt.Fprintf(o, "Options:\n")
+	fmt.Fprintf(o, "-r {string} Write each character not encodable as Latin-1 as this string, e.g. '?'.\n")
 	fmt.Fprintf(o, "-h|--help Show this message.\n")
 }
 
@@ -42,8 +45,12 @@ func transformerUTF8ToLatin1ParseCLI(
 
 	// Skip the verb name from the current spot in the mlr command line
 	argi := *pargi
+	verb := args[argi]
 	argi++
 
+	doReplace := false
+	replacement := ""
+
 	for argi < argc /* variable increment: 1 or 2 depending on flag */ {
 		opt := args[argi]
 		if !strings.HasPrefix(opt, "-") {
@@ -58,6 +65,10 @@ func transformerUTF8ToLatin1ParseCLI(
 			transformerUTF8ToLatin1Usage(os.Stdout)
 			os.Exit(0)
 
+		} else if opt == "-r" {
+			doReplace = true
+			replacement = cli.VerbGetStringArgOrDie(verb, opt, args, &argi, argc)
+
 		} else {
 			transformerUTF8ToLatin1Usage(os.Stderr)
 			os.Exit(1)
@@ -69,7 +80,7 @@ func transformerUTF8ToLatin1ParseCLI(
 		return nil
 	}
 
-	transformer, err := NewTransformerUTF8ToLatin1()
+	transformer, err := NewTransformerUTF8ToLatin1(doReplace, replacement)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
@@ -80,10 +91,25 @@ func transformerUTF8ToLatin1ParseCLI(
 
 // ----------------------------------------------------------------
 type TransformerUTF8ToLatin1 struct {
+	converter bifs.UnaryFunc
 }
 
-func NewTransformerUTF8ToLatin1() (*TransformerUTF8ToLatin1, error) {
-	tr := &TransformerUTF8ToLatin1{}
+func NewTransformerUTF8ToLatin1(
+	doReplace bool,
+	replacement string,
+) (*TransformerUTF8ToLatin1, error) {
+	tr := &TransformerUTF8ToLatin1{
+		converter: bifs.BIF_utf8_to_latin1,
+	}
+	if doReplace {
+		latin1Replacement, err := lib.TryUTF8ToLatin1(replacement)
+		if err != nil {
+			return nil, fmt.Errorf("mlr %s: replacement \"%s\" is not encodable as Latin-1", verbNameUTF8ToLatin1, replacement)
+		}
+		tr.converter = func(input1 *mlrval.Mlrval) *mlrval.Mlrval {
+			return bifs.UTF8ToLatin1Replacing(input1, latin1Replacement)
+		}
+	}
 	return tr, nil
 }
 
@@ -100,15 +126,7 @@ func (tr *TransformerUTF8ToLatin1) Transform(
 		inrec := inrecAndContext.Record
 
 		for pe := inrec.Head; pe != nil; pe = pe.Next {
-			inval := pe.Value
-			if inval.IsString() {
-				output, err := lib.TryUTF8ToLatin1(pe.Value.String())
-				if err == nil {
-					pe.Value = mlrval.FromString(output)
-				} else {
-					pe.Value = mlrval.FromError(err)
-				}
-			}
+			pe.Value = tr.converter(pe.Value)
 		}
 
 		outputRecordsAndContexts.PushBack(types.NewRecordAndContext(inrec, &inrecAndContext.Context))
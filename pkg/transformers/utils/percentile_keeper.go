@@ -1,5 +1,12 @@
 // ================================================================
-// TODO: comment here
+// PercentileKeeper buffers values, then sorts them on the first request for a
+// percentile. With n sorted values x[0] through x[n-1], the percentile pN is:
+//
+// * Non-interpolated: x[int(N*n/100)], or x[n-1] if that index is past the
+//   end.
+// * Interpolated: x[i] + f*(x[i+1]-x[i]) where i is the integer part and f is
+//   the fractional part of N*(n-1)/100. This is the same as numpy's default
+//   and R's type=7.
 // ================================================================
 
 package utils
@@ -90,22 +97,23 @@ func (keeper *PercentileKeeper) EmitLinearlyInterpolated(percentile float64) *ml
 }
 
 // ----------------------------------------------------------------
-// TODO: COMMENT
+// EmitNamed handles the named statistics for the summary verb, and iqr for
+// stats1. They are interpolated if the keeper was constructed that way.
 func (keeper *PercentileKeeper) EmitNamed(name string) *mlrval.Mlrval {
 	if name == "min" {
-		return keeper.EmitNonInterpolated(0.0)
+		return keeper.Emit(0.0)
 	} else if name == "p25" {
-		return keeper.EmitNonInterpolated(25.0)
+		return keeper.Emit(25.0)
 	} else if name == "median" {
-		return keeper.EmitNonInterpolated(50.0)
+		return keeper.Emit(50.0)
 	} else if name == "p75" {
-		return keeper.EmitNonInterpolated(75.0)
+		return keeper.Emit(75.0)
 	} else if name == "max" {
-		return keeper.EmitNonInterpolated(100.0)
+		return keeper.Emit(100.0)
 
 	} else if name == "iqr" {
-		p25 := keeper.EmitNonInterpolated(25.0)
-		p75 := keeper.EmitNonInterpolated(75.0)
+		p25 := keeper.Emit(25.0)
+		p75 := keeper.Emit(75.0)
 		if p25.IsNumeric() && p75.IsNumeric() {
 			return bifs.BIF_minus_binary(p75, p25)
 		} else {
@@ -113,7 +121,7 @@ func (keeper *PercentileKeeper) EmitNamed(name string) *mlrval.Mlrval {
 		}
 
 	} else if name == "lof" {
-		p25 := keeper.EmitNonInterpolated(25.0)
+		p25 := keeper.Emit(25.0)
 		iqr := keeper.EmitNamed("iqr")
 		if p25.IsNumeric() && iqr.IsNumeric() {
 			return bifs.BIF_minus_binary(p25, bifs.BIF_times(fenceOuterK, iqr))
@@ -122,7 +130,7 @@ func (keeper *PercentileKeeper) EmitNamed(name string) *mlrval.Mlrval {
 		}
 
 	} else if name == "lif" {
-		p25 := keeper.EmitNonInterpolated(25.0)
+		p25 := keeper.Emit(25.0)
 		iqr := keeper.EmitNamed("iqr")
 		if p25.IsNumeric() && iqr.IsNumeric() {
 			return bifs.BIF_minus_binary(p25, bifs.BIF_times(fenceInnerK, iqr))
@@ -131,7 +139,7 @@ func (keeper *PercentileKeeper) EmitNamed(name string) *mlrval.Mlrval {
 		}
 
 	} else if name == "uif" {
-		p75 := keeper.EmitNonInterpolated(75.0)
+		p75 := keeper.Emit(75.0)
 		iqr := keeper.EmitNamed("iqr")
 		if p75.IsNumeric() && iqr.IsNumeric() {
 			return bifs.BIF_plus_binary(p75, bifs.BIF_times(fenceInnerK, iqr))
@@ -140,7 +148,7 @@ func (keeper *PercentileKeeper) EmitNamed(name string) *mlrval.Mlrval {
 		}
 
 	} else if name == "uof" {
-		p75 := keeper.EmitNonInterpolated(75.0)
+		p75 := keeper.Emit(75.0)
 		iqr := keeper.EmitNamed("iqr")
 		if p75.IsNumeric() && iqr.IsNumeric() {
 			return bifs.BIF_plus_binary(p75, bifs.BIF_times(fenceOuterK, iqr))
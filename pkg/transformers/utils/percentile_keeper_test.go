@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnkerl/miller/pkg/mlrval"
+)
+
+func newPercentileKeeperWith(doInterpolatedPercentiles bool, values ...int64) *PercentileKeeper {
+	keeper := NewPercentileKeeper(doInterpolatedPercentiles)
+	for _, value := range values {
+		keeper.Ingest(mlrval.FromInt(value))
+	}
+	return keeper
+}
+
+func TestPercentileKeeperNonInterpolated(t *testing.T) {
+	keeper := newPercentileKeeperWith(false, 4, 1, 3, 2)
+	assert.Equal(t, "1", keeper.Emit(0.0).String())
+	assert.Equal(t, "2", keeper.Emit(25.0).String())
+	assert.Equal(t, "3", keeper.Emit(50.0).String())
+	assert.Equal(t, "4", keeper.Emit(75.0).String())
+	assert.Equal(t, "4", keeper.Emit(100.0).String())
+	assert.Equal(t, "2", keeper.EmitNamed("iqr").String())
+}
+
+func TestPercentileKeeperInterpolated(t *testing.T) {
+	// Same as numpy.percentile([1, 2, 3, 4], [0, 25, 50, 75, 100])
+	keeper := newPercentileKeeperWith(true, 4, 1, 3, 2)
+	assert.Equal(t, "1", keeper.Emit(0.0).String())
+	assert.Equal(t, "1.75", keeper.Emit(25.0).String())
+	assert.Equal(t, "2.5", keeper.Emit(50.0).String())
+	assert.Equal(t, "3.25", keeper.Emit(75.0).String())
+	assert.Equal(t, "4", keeper.Emit(100.0).String())
+	assert.Equal(t, "1.5", keeper.EmitNamed("iqr").String())
+	assert.Equal(t, "2.5", keeper.EmitNamed("median").String())
+}
+
+func TestPercentileKeeperEmpty(t *testing.T) {
+	keeper := newPercentileKeeperWith(true)
+	assert.Equal(t, "", keeper.Emit(50.0).String())
+	assert.Equal(t, "", keeper.EmitNamed("iqr").String())
+}
@@ -62,6 +62,17 @@ var stats1AccumulatorInfos []stats1AccumulatorInfo = []stats1AccumulatorInfo{
 		NewStats1AntimodeAccumulator,
 	},
 
+	{
+		"first",
+		"Find first non-empty values of fields, of any type",
+		NewStats1FirstAccumulator,
+	},
+	{
+		"last",
+		"Find last non-empty values of fields, of any type",
+		NewStats1LastAccumulator,
+	},
+
 	{
 		"sum",
 		"Compute sums of specified fields",
@@ -150,7 +161,14 @@ func NewStats1NamedAccumulator(
 	}
 }
 
+// Ingest passes empty and JSON-null values only to null_count: the other
+// accumulators count, sum, etc. only the values which are present.
 func (nacc *Stats1NamedAccumulator) Ingest(value *mlrval.Mlrval) {
+	if value.IsVoid() || value.IsNull() {
+		if nacc.accumulatorName != "null_count" {
+			return
+		}
+	}
 	nacc.accumulator.Ingest(value)
 }
 
@@ -191,7 +209,7 @@ func ValidateStats1AccumulatorName(
 ) bool {
 	// First try percentiles, which have parameterized names.
 	_, ok := tryPercentileFromName(accumulatorName)
-	if ok {
+	if ok || accumulatorName == "iqr" {
 		return true
 	}
 
@@ -208,7 +226,7 @@ func ValidateStats1AccumulatorName(
 // * "p95"   -> 95.0, true
 // * "p99.9" -> 99.9, true
 // * "p200"  -> _, false
-// * "median" -> 50.0, false
+// * "median" -> 50.0, true
 // * "nonesuch" -> _, false
 func tryPercentileFromName(accumulatorName string) (float64, bool) {
 	if accumulatorName == "median" {
@@ -263,6 +281,32 @@ func (factory *Stats1AccumulatorFactory) MakeNamedAccumulator(
 	)
 }
 
+// To conserve memory, percentile-keepers on the same value-field-name (and
+// grouping-key) are shared. For example, p25,p75 on field "x". This means
+// though that each datapoint must be ingested only once (e.g.  by the p25
+// accumulator) since it shares a percentile-keeper with the p75 accumulator.
+// We handle this by tracking the first construction, which is returned with
+// isPrimary true.
+func (factory *Stats1AccumulatorFactory) getPercentileKeeper(
+	groupingKey string,
+	valueFieldName string,
+	doInterpolatedPercentiles bool,
+) (percentileKeeper *PercentileKeeper, isPrimary bool) {
+	percentileKeepersForValueFieldName := factory.percentileKeepers[valueFieldName]
+	if percentileKeepersForValueFieldName == nil {
+		percentileKeepersForValueFieldName = make(map[string]*PercentileKeeper)
+		factory.percentileKeepers[valueFieldName] = percentileKeepersForValueFieldName
+	}
+
+	percentileKeeper = percentileKeepersForValueFieldName[groupingKey]
+	if percentileKeeper == nil {
+		percentileKeeper = NewPercentileKeeper(doInterpolatedPercentiles)
+		percentileKeepersForValueFieldName[groupingKey] = percentileKeeper
+		isPrimary = true
+	}
+	return percentileKeeper, isPrimary
+}
+
 func (factory *Stats1AccumulatorFactory) MakeAccumulator(
 	accumulatorName string,
 	groupingKey string,
@@ -272,28 +316,13 @@ func (factory *Stats1AccumulatorFactory) MakeAccumulator(
 	// First try percentiles, which have parameterized names.
 	percentile, ok := tryPercentileFromName(accumulatorName)
 	if ok {
-		percentileKeepersForValueFieldName := factory.percentileKeepers[valueFieldName]
-		if percentileKeepersForValueFieldName == nil {
-			percentileKeepersForValueFieldName = make(map[string]*PercentileKeeper)
-			factory.percentileKeepers[valueFieldName] = percentileKeepersForValueFieldName
-		}
-
-		percentileKeeper := percentileKeepersForValueFieldName[groupingKey]
-		isPrimary := false
-		if percentileKeeper == nil {
-			percentileKeeper = NewPercentileKeeper(doInterpolatedPercentiles)
-			percentileKeepersForValueFieldName[groupingKey] = percentileKeeper
-			isPrimary = true
-		}
-
-		// To conserve memory, percentile-keeprs on the same value-field-name
-		// (and grouping-key) are shared. For example, p25,p75 on field "x".
-		// This means though that each datapoint must be ingested only once
-		// (e.g.  by the p25 accumulator) since it shares a percentile-keeper
-		// with the p75 accumulator. We handle this by tracking the first
-		// construction.
+		percentileKeeper, isPrimary := factory.getPercentileKeeper(groupingKey, valueFieldName, doInterpolatedPercentiles)
 		return NewStats1PercentileAccumulator(percentileKeeper, percentile, isPrimary)
 	}
+	if accumulatorName == "iqr" {
+		percentileKeeper, isPrimary := factory.getPercentileKeeper(groupingKey, valueFieldName, doInterpolatedPercentiles)
+		return NewStats1IQRAccumulator(percentileKeeper, isPrimary)
+	}
 
 	// Then try the lookup table.
 	for _, info := range stats1AccumulatorInfos {
@@ -375,6 +404,25 @@ func (acc *Stats1DistinctCountAccumulator) Reset() {
 	acc.distincts = lib.NewOrderedMap()
 }
 
+// ----------------------------------------------------------------
+// For mode and antimode: the first-seen value for each distinct string
+// representation, with its count. The first-seen value is kept so that e.g.
+// numbers from JSON input are emitted as numbers.
+type stats1ValueCount struct {
+	value *mlrval.Mlrval
+	count int64
+}
+
+func ingestValueCount(countsByValue *lib.OrderedMap, value *mlrval.Mlrval) {
+	key := value.String() // 1, 1.0, and 1.000 are distinct
+	iPrevious, ok := countsByValue.GetWithCheck(key)
+	if !ok {
+		countsByValue.Put(key, &stats1ValueCount{value: value.Copy(), count: 1})
+	} else {
+		iPrevious.(*stats1ValueCount).count++
+	}
+}
+
 // ----------------------------------------------------------------
 type Stats1ModeAccumulator struct {
 	// Needs to be an ordered map to guarantee Miller's semantics that
@@ -388,29 +436,21 @@ func NewStats1ModeAccumulator() IStats1Accumulator {
 	}
 }
 func (acc *Stats1ModeAccumulator) Ingest(value *mlrval.Mlrval) {
-	key := value.String() // 1, 1.0, and 1.000 are distinct
-	iPrevious, ok := acc.countsByValue.GetWithCheck(key)
-	if !ok {
-		acc.countsByValue.Put(key, int64(1))
-	} else {
-		acc.countsByValue.Put(key, iPrevious.(int64)+1)
-	}
+	ingestValueCount(acc.countsByValue, value)
 }
 func (acc *Stats1ModeAccumulator) Emit() *mlrval.Mlrval {
-	if acc.countsByValue.IsEmpty() {
-		return mlrval.VOID
-	}
-	maxValue := ""
-	var maxCount = int64(0)
+	var maxValueCount *stats1ValueCount = nil
 	for pe := acc.countsByValue.Head; pe != nil; pe = pe.Next {
-		value := pe.Key
-		count := pe.Value.(int64)
-		if maxValue == "" || count > maxCount {
-			maxValue = value
-			maxCount = count
+		valueCount := pe.Value.(*stats1ValueCount)
+		// Strictly greater, so that the first-found wins ties
+		if maxValueCount == nil || valueCount.count > maxValueCount.count {
+			maxValueCount = valueCount
 		}
 	}
-	return mlrval.FromString(maxValue)
+	if maxValueCount == nil {
+		return mlrval.VOID
+	}
+	return maxValueCount.value.Copy()
 }
 func (acc *Stats1ModeAccumulator) Reset() {
 	acc.countsByValue = lib.NewOrderedMap()
@@ -429,34 +469,76 @@ func NewStats1AntimodeAccumulator() IStats1Accumulator {
 	}
 }
 func (acc *Stats1AntimodeAccumulator) Ingest(value *mlrval.Mlrval) {
-	key := value.String() // 1, 1.0, and 1.000 are distinct
-	iPrevious, ok := acc.countsByValue.GetWithCheck(key)
-	if !ok {
-		acc.countsByValue.Put(key, int64(1))
-	} else {
-		acc.countsByValue.Put(key, iPrevious.(int64)+1)
-	}
+	ingestValueCount(acc.countsByValue, value)
 }
 func (acc *Stats1AntimodeAccumulator) Emit() *mlrval.Mlrval {
-	if acc.countsByValue.IsEmpty() {
-		return mlrval.VOID
-	}
-	minValue := ""
-	var minCount = int64(0)
+	var minValueCount *stats1ValueCount = nil
 	for pe := acc.countsByValue.Head; pe != nil; pe = pe.Next {
-		value := pe.Key
-		count := pe.Value.(int64)
-		if minValue == "" || count < minCount {
-			minValue = value
-			minCount = count
+		valueCount := pe.Value.(*stats1ValueCount)
+		// Strictly less, so that the first-found wins ties
+		if minValueCount == nil || valueCount.count < minValueCount.count {
+			minValueCount = valueCount
 		}
 	}
-	return mlrval.FromString(minValue)
+	if minValueCount == nil {
+		return mlrval.VOID
+	}
+	return minValueCount.value.Copy()
 }
 func (acc *Stats1AntimodeAccumulator) Reset() {
 	acc.countsByValue = lib.NewOrderedMap()
 }
 
+// ----------------------------------------------------------------
+type Stats1FirstAccumulator struct {
+	first *mlrval.Mlrval
+}
+
+func NewStats1FirstAccumulator() IStats1Accumulator {
+	return &Stats1FirstAccumulator{
+		first: mlrval.ABSENT,
+	}
+}
+func (acc *Stats1FirstAccumulator) Ingest(value *mlrval.Mlrval) {
+	if acc.first.IsAbsent() {
+		acc.first = value.Copy()
+	}
+}
+func (acc *Stats1FirstAccumulator) Emit() *mlrval.Mlrval {
+	if acc.first.IsAbsent() {
+		return mlrval.VOID
+	} else {
+		return acc.first.Copy()
+	}
+}
+func (acc *Stats1FirstAccumulator) Reset() {
+	acc.first = mlrval.ABSENT
+}
+
+// ----------------------------------------------------------------
+type Stats1LastAccumulator struct {
+	last *mlrval.Mlrval
+}
+
+func NewStats1LastAccumulator() IStats1Accumulator {
+	return &Stats1LastAccumulator{
+		last: mlrval.ABSENT,
+	}
+}
+func (acc *Stats1LastAccumulator) Ingest(value *mlrval.Mlrval) {
+	acc.last = value.Copy()
+}
+func (acc *Stats1LastAccumulator) Emit() *mlrval.Mlrval {
+	if acc.last.IsAbsent() {
+		return mlrval.VOID
+	} else {
+		return acc.last.Copy()
+	}
+}
+func (acc *Stats1LastAccumulator) Reset() {
+	acc.last = mlrval.ABSENT
+}
+
 // ----------------------------------------------------------------
 type Stats1SumAccumulator struct {
 	sum *mlrval.Mlrval
@@ -639,97 +721,102 @@ func (acc *Stats1MaxLenAccumulator) Reset() {
 }
 
 // ----------------------------------------------------------------
-type Stats1VarAccumulator struct {
+// Welford's streaming algorithm for mean and variance. Unlike accumulating
+// sums of x and x^2, this doesn't lose precision through catastrophic
+// cancellation when the variance is small relative to the mean -- e.g.
+// timestamps, or other large values varying only in their last digits.
+type welfordState struct {
 	count int64
-	sum   *mlrval.Mlrval
-	sum2  *mlrval.Mlrval
+	mean  float64
+	m2    float64 // sum of squared differences from the current mean
 }
 
-func NewStats1VarAccumulator() IStats1Accumulator {
-	return &Stats1VarAccumulator{
-		count: 0,
-		sum:   mlrval.FromInt(0),
-		sum2:  mlrval.FromInt(0),
+func (state *welfordState) ingest(value *mlrval.Mlrval) {
+	x, ok := value.GetNumericToFloatValue()
+	if !ok {
+		return
 	}
+	state.count++
+	delta := x - state.mean
+	state.mean += delta / float64(state.count)
+	state.m2 += delta * (x - state.mean)
 }
-func (acc *Stats1VarAccumulator) Ingest(value *mlrval.Mlrval) {
-	if value.IsNumeric() {
-		value2 := bifs.BIF_times(value, value)
-		acc.count++
-		acc.sum = bifs.BIF_plus_binary(acc.sum, value)
-		acc.sum2 = bifs.BIF_plus_binary(acc.sum2, value2)
+
+// variance returns the unbiased sample variance, or void if there are fewer
+// than two values.
+func (state *welfordState) variance() *mlrval.Mlrval {
+	if state.count < 2 {
+		return mlrval.VOID
 	}
+	return mlrval.FromFloat(state.m2 / float64(state.count-1))
+}
+
+func (state *welfordState) reset() {
+	state.count = 0
+	state.mean = 0.0
+	state.m2 = 0.0
+}
+
+// ----------------------------------------------------------------
+type Stats1VarAccumulator struct {
+	welford welfordState
+}
+
+func NewStats1VarAccumulator() IStats1Accumulator {
+	return &Stats1VarAccumulator{}
+}
+func (acc *Stats1VarAccumulator) Ingest(value *mlrval.Mlrval) {
+	acc.welford.ingest(value)
 }
 func (acc *Stats1VarAccumulator) Emit() *mlrval.Mlrval {
-	return bifs.BIF_finalize_variance(mlrval.FromInt(acc.count), acc.sum, acc.sum2)
+	return acc.welford.variance()
 }
 func (acc *Stats1VarAccumulator) Reset() {
-	acc.count = 0
-	acc.sum = mlrval.FromInt(0)
-	acc.sum2 = mlrval.FromInt(0)
+	acc.welford.reset()
 }
 
 // ----------------------------------------------------------------
 type Stats1StddevAccumulator struct {
-	count int64
-	sum   *mlrval.Mlrval
-	sum2  *mlrval.Mlrval
+	welford welfordState
 }
 
 func NewStats1StddevAccumulator() IStats1Accumulator {
-	return &Stats1StddevAccumulator{
-		count: 0,
-		sum:   mlrval.FromInt(0),
-		sum2:  mlrval.FromInt(0),
-	}
+	return &Stats1StddevAccumulator{}
 }
 func (acc *Stats1StddevAccumulator) Ingest(value *mlrval.Mlrval) {
-	if value.IsNumeric() {
-		value2 := bifs.BIF_times(value, value)
-		acc.count++
-		acc.sum = bifs.BIF_plus_binary(acc.sum, value)
-		acc.sum2 = bifs.BIF_plus_binary(acc.sum2, value2)
-	}
+	acc.welford.ingest(value)
 }
 func (acc *Stats1StddevAccumulator) Emit() *mlrval.Mlrval {
-	return bifs.BIF_finalize_stddev(mlrval.FromInt(acc.count), acc.sum, acc.sum2)
+	mvar := acc.welford.variance()
+	if mvar.IsVoid() {
+		return mvar
+	}
+	return bifs.BIF_sqrt(mvar)
 }
 func (acc *Stats1StddevAccumulator) Reset() {
-	acc.count = 0
-	acc.sum = mlrval.FromInt(0)
-	acc.sum2 = mlrval.FromInt(0)
+	acc.welford.reset()
 }
 
 // ----------------------------------------------------------------
 type Stats1MeanEBAccumulator struct {
-	count int64
-	sum   *mlrval.Mlrval
-	sum2  *mlrval.Mlrval
+	welford welfordState
 }
 
 func NewStats1MeanEBAccumulator() IStats1Accumulator {
-	return &Stats1MeanEBAccumulator{
-		count: 0,
-		sum:   mlrval.FromInt(0),
-		sum2:  mlrval.FromInt(0),
-	}
+	return &Stats1MeanEBAccumulator{}
 }
 func (acc *Stats1MeanEBAccumulator) Ingest(value *mlrval.Mlrval) {
-	if value.IsNumeric() {
-		value2 := bifs.BIF_times(value, value)
-		acc.count++
-		acc.sum = bifs.BIF_plus_binary(acc.sum, value)
-		acc.sum2 = bifs.BIF_plus_binary(acc.sum2, value2)
-	}
+	acc.welford.ingest(value)
 }
 func (acc *Stats1MeanEBAccumulator) Emit() *mlrval.Mlrval {
-	mcount := mlrval.FromInt(acc.count)
-	return bifs.BIF_finalize_mean_eb(mcount, acc.sum, acc.sum2)
+	mvar := acc.welford.variance()
+	if mvar.IsVoid() {
+		return mvar
+	}
+	return bifs.BIF_sqrt(bifs.BIF_divide(mvar, mlrval.FromInt(acc.welford.count)))
 }
 func (acc *Stats1MeanEBAccumulator) Reset() {
-	acc.count = 0
-	acc.sum = mlrval.FromInt(0)
-	acc.sum2 = mlrval.FromInt(0)
+	acc.welford.reset()
 }
 
 // ----------------------------------------------------------------
@@ -850,3 +937,36 @@ func (acc *Stats1PercentileAccumulator) Reset() {
 		acc.percentileKeeper.Reset()
 	}
 }
+
+// ----------------------------------------------------------------
+// The interquartile range p75 - p25, sharing the percentile-keeper as above.
+type Stats1IQRAccumulator struct {
+	percentileKeeper *PercentileKeeper
+	isPrimary        bool
+}
+
+func NewStats1IQRAccumulator(
+	percentileKeeper *PercentileKeeper,
+	isPrimary bool,
+) IStats1Accumulator {
+	return &Stats1IQRAccumulator{
+		percentileKeeper: percentileKeeper,
+		isPrimary:        isPrimary,
+	}
+}
+
+func (acc *Stats1IQRAccumulator) Ingest(value *mlrval.Mlrval) {
+	if acc.isPrimary {
+		acc.percentileKeeper.Ingest(value)
+	}
+}
+
+func (acc *Stats1IQRAccumulator) Emit() *mlrval.Mlrval {
+	return acc.percentileKeeper.EmitNamed("iqr")
+}
+
+func (acc *Stats1IQRAccumulator) Reset() {
+	if acc.isPrimary {
+		acc.percentileKeeper.Reset()
+	}
+}
@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnkerl/miller/pkg/mlrval"
+)
+
+func emitAfterIngesting(acc IStats1Accumulator, values ...*mlrval.Mlrval) *mlrval.Mlrval {
+	for _, value := range values {
+		acc.Ingest(value)
+	}
+	return acc.Emit()
+}
+
+func TestStats1ModeAntimodeTies(t *testing.T) {
+	b := mlrval.FromString("b")
+	a := mlrval.FromString("a")
+	c := mlrval.FromString("c")
+
+	// b and a tie for most frequent; b was seen first
+	assert.Equal(t, "b", emitAfterIngesting(NewStats1ModeAccumulator(), b, a, a, b, c).String())
+	// b and c tie for least frequent; b was seen first
+	assert.Equal(t, "b", emitAfterIngesting(NewStats1AntimodeAccumulator(), b, a, a, c).String())
+	// Reversing the input order reverses the winner
+	assert.Equal(t, "a", emitAfterIngesting(NewStats1ModeAccumulator(), a, b, b, a, c).String())
+	assert.Equal(t, "c", emitAfterIngesting(NewStats1AntimodeAccumulator(), c, a, a, b).String())
+
+	assert.Equal(t, "", NewStats1ModeAccumulator().Emit().String())
+	assert.Equal(t, "", NewStats1AntimodeAccumulator().Emit().String())
+}
+
+func TestStats1ModeKeepsType(t *testing.T) {
+	mode := emitAfterIngesting(NewStats1ModeAccumulator(),
+		mlrval.FromInt(3), mlrval.FromString("x"), mlrval.FromInt(3))
+	assert.True(t, mode.IsInt())
+	assert.Equal(t, "3", mode.String())
+}
+
+func TestStats1FirstLast(t *testing.T) {
+	values := []*mlrval.Mlrval{mlrval.FromInt(1), mlrval.FromString("abc"), mlrval.FromFloat(2.5)}
+
+	// first ignores later values; last reflects the final one
+	assert.Equal(t, "1", emitAfterIngesting(NewStats1FirstAccumulator(), values...).String())
+	assert.Equal(t, "2.5", emitAfterIngesting(NewStats1LastAccumulator(), values...).String())
+
+	assert.Equal(t, "", NewStats1FirstAccumulator().Emit().String())
+	assert.Equal(t, "", NewStats1LastAccumulator().Emit().String())
+
+	acc := NewStats1FirstAccumulator()
+	emitAfterIngesting(acc, values...)
+	acc.Reset()
+	assert.Equal(t, "abc", emitAfterIngesting(acc, values[1:]...).String())
+}
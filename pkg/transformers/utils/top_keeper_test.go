@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnkerl/miller/pkg/mlrval"
+	"github.com/johnkerl/miller/pkg/types"
+)
+
+// Feeds the top-keeper records with the given values, tagging each record with
+// its 1-up input position so tie-breaking can be checked.
+func fillTopKeeper(keeper *TopKeeper, values []int64) {
+	context := types.NewNilContext()
+	for i, value := range values {
+		record := mlrval.NewMlrmapAsRecord()
+		record.PutCopy("id", mlrval.FromInt(int64(i+1)))
+		keeper.Add(mlrval.FromInt(value), types.NewRecordAndContext(record, context))
+	}
+}
+
+func topKeeperValuesAndIDs(keeper *TopKeeper) ([]int64, []int64) {
+	values := make([]int64, keeper.GetSize())
+	ids := make([]int64, keeper.GetSize())
+	for i := int64(0); i < keeper.GetSize(); i++ {
+		values[i], _ = keeper.TopValues[i].GetIntValue()
+		ids[i], _ = keeper.TopRecordsAndContexts[i].Record.Get("id").GetIntValue()
+	}
+	return values, ids
+}
+
+func TestTopKeeperMax(t *testing.T) {
+	keeper := NewTopKeeper(3, true)
+	fillTopKeeper(keeper, []int64{5, 1, 9, 7, 3, 8})
+	assert.Equal(t, int64(3), keeper.GetSize())
+	values, ids := topKeeperValuesAndIDs(keeper)
+	assert.Equal(t, []int64{9, 8, 7}, values)
+	assert.Equal(t, []int64{3, 6, 4}, ids)
+}
+
+func TestTopKeeperMin(t *testing.T) {
+	keeper := NewTopKeeper(3, false)
+	fillTopKeeper(keeper, []int64{5, 1, 9, 7, 3, 8})
+	assert.Equal(t, int64(3), keeper.GetSize())
+	values, ids := topKeeperValuesAndIDs(keeper)
+	assert.Equal(t, []int64{1, 3, 5}, values)
+	assert.Equal(t, []int64{2, 5, 1}, ids)
+}
+
+func TestTopKeeperFewerThanCapacity(t *testing.T) {
+	keeper := NewTopKeeper(5, true)
+	fillTopKeeper(keeper, []int64{2, 4})
+	assert.Equal(t, int64(2), keeper.GetSize())
+	values, ids := topKeeperValuesAndIDs(keeper)
+	assert.Equal(t, []int64{4, 2}, values)
+	assert.Equal(t, []int64{2, 1}, ids)
+}
+
+// Among equal values, the earlier records are kept, and are kept in input order.
+func TestTopKeeperTiesMax(t *testing.T) {
+	keeper := NewTopKeeper(3, true)
+	fillTopKeeper(keeper, []int64{4, 6, 4, 6, 4, 6, 4, 6})
+	assert.Equal(t, int64(3), keeper.GetSize())
+	values, ids := topKeeperValuesAndIDs(keeper)
+	assert.Equal(t, []int64{6, 6, 6}, values)
+	assert.Equal(t, []int64{2, 4, 6}, ids)
+}
+
+func TestTopKeeperTiesMin(t *testing.T) {
+	keeper := NewTopKeeper(3, false)
+	fillTopKeeper(keeper, []int64{4, 4, 6, 2, 4, 2})
+	assert.Equal(t, int64(3), keeper.GetSize())
+	values, ids := topKeeperValuesAndIDs(keeper)
+	assert.Equal(t, []int64{2, 2, 4}, values)
+	assert.Equal(t, []int64{4, 6, 1}, ids)
+}